@@ -171,6 +171,14 @@ func (h *IPCHandler) handleLint(ctx context.Context, req api.LintRequest, dispat
 	if len(req.CanonicalFiles) > 0 && len(req.CanonicalFiles) != len(req.Files) {
 		return nil, errors.New("canonicalFiles must be parallel to files")
 	}
+	var unusedDirectiveSeverity rule.DiagnosticSeverity
+	if req.ReportUnusedDisableDirectives != "" {
+		severity, ok := rule.ParseSeverityLevel(req.ReportUnusedDisableDirectives)
+		if !ok {
+			return nil, fmt.Errorf("invalid reportUnusedDisableDirectives %q", req.ReportUnusedDisableDirectives)
+		}
+		unusedDirectiveSeverity = severity
+	}
 	canonicalPaths := make(map[string]string, len(req.CanonicalFiles))
 	for index, canonicalPath := range req.CanonicalFiles {
 		filePath := resolveRequestPath(req.Files[index])
@@ -683,12 +691,18 @@ func (h *IPCHandler) handleLint(ctx context.Context, req api.LintRequest, dispat
 			}
 			return enabledRules
 		},
+		GetEnvironmentForFile: func(sourceFile *ast.SourceFile) *rule.RuleEnvironment {
+			return fileConfigResolver.EnvironmentForFile(sourceFile.FileName())
+		},
 		// The API returns concrete fixes, suggestions, and fixable counts
 		// independently of whether req.Fix later applies autofixes.
 		Consumer: rule.DiagnosticConsumer{
 			Demand: rule.EditDemandAll,
 			Report: diagnosticCollector,
 		},
+		NoInlineConfig:                  req.NoInlineConfig,
+		OverrideUnusedDisableDirectives: req.ReportUnusedDisableDirectives != "",
+		UnusedDisableDirectiveSeverity:  unusedDirectiveSeverity,
 	}
 	preparedPlan, err := linter.PrepareLintPlan(runOpts)
 	if err != nil {
@@ -715,7 +729,7 @@ func (h *IPCHandler) handleLint(ctx context.Context, req api.LintRequest, dispat
 		pluginInputs := buildPluginFileInputs(runOpts.PreparedPlan, pluginConfigResolver{
 			lintResolver:           fileConfigResolver,
 			pluginConfigDirByOwner: pluginConfigDirByOwner,
-		})
+		}, req.NoInlineConfig)
 		for i := range pluginInputs {
 			// Programmatic lint supports in-memory overlays. Always send the exact
 			// parsed source frame instead of asking the host to re-read disk.
@@ -891,6 +905,37 @@ func addEquivalentFileContentPaths(fileContents map[string]string, configDirecto
 	}
 }
 
+// HandleGetRuleMeta reports the metadata of registered rules in IPC mode
+func (h *IPCHandler) HandleGetRuleMeta(req api.GetRuleMetaRequest) (*api.GetRuleMetaResponse, error) {
+	rslintconfig.RegisterAllRules()
+	names := req.Rules
+	if len(names) == 0 {
+		for name := range rslintconfig.GlobalRuleRegistry.GetAllRules() {
+			names = append(names, name)
+		}
+	}
+	response := &api.GetRuleMetaResponse{Rules: make([]api.RuleMeta, 0, len(names))}
+	for _, name := range names {
+		ruleImpl, exists := rslintconfig.GlobalRuleRegistry.GetRule(name)
+		if !exists {
+			continue
+		}
+		meta := ruleImpl.Meta
+		response.Rules = append(response.Rules, api.RuleMeta{
+			Name:           name,
+			Type:           string(meta.Type),
+			DocsURL:        meta.DocsURL,
+			Fixable:        string(meta.Fixable),
+			HasSuggestions: meta.HasSuggestions,
+			Deprecated:     meta.Deprecated,
+			ReplacedBy:     meta.ReplacedBy,
+			MessageIds:     meta.MessageIds,
+		})
+	}
+	sort.Slice(response.Rules, func(i, j int) bool { return response.Rules[i].Name < response.Rules[j].Name })
+	return response, nil
+}
+
 // HandleGetAstInfo handles get AST info requests in IPC mode
 func (h *IPCHandler) HandleGetAstInfo(req api.GetAstInfoRequest) (*api.GetAstInfoResponse, error) {
 	// Fixed user file name for program creation
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"flag"
 	"fmt"
+	"maps"
 	"os"
 	"path/filepath"
 	"runtime"
@@ -18,6 +19,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/web-infra-dev/rslint/internal/lintcache"
 	"github.com/web-infra-dev/rslint/internal/linter"
 	"github.com/web-infra-dev/rslint/internal/output"
 	"github.com/web-infra-dev/rslint/internal/program/loader"
@@ -62,6 +64,17 @@ type lintArgs struct {
 	MaxWarnings int
 	StartTimeMs int64
 	RuleFlags   []string
+	// Cache enables the persistent lint result cache at CacheLocation (a file,
+	// or a directory holding .rslintcache), validated by CacheStrategy.
+	Cache         bool
+	CacheLocation string
+	CacheStrategy string
+	// NoInlineConfig ignores directive comments regardless of linterOptions.
+	NoInlineConfig bool
+	// OverrideUnusedDisableDirectives replaces linterOptions'
+	// reportUnusedDisableDirectives with UnusedDisableDirectiveSeverity.
+	OverrideUnusedDisableDirectives bool
+	UnusedDisableDirectiveSeverity  rule.DiagnosticSeverity
 	// Positional args resolved into existing-dir vs file paths.
 	AllowFiles []string
 	AllowDirs  []string
@@ -94,7 +107,7 @@ Usage:
 Options:
   --init                Initialize a default config in the current directory.
   -c, --config PATH     Which rslint config file to use.
-  --format FORMAT       Output format: default | jsonline | github | gitlab
+  --format FORMAT       Output format: default | jsonline | github | gitlab | sarif
   --fix                 Automatically fix problems
   --type-check          Enable TypeScript type checking
   --type-check-only     Run only TypeScript type checking (skip all lint rules)
@@ -104,6 +117,14 @@ Options:
   --timing [all|N]      Print a per-rule timing table (all rules, or top N)
   --max-warnings Int    Number of warnings to trigger nonzero exit code
   --rule RULE           Rule override, e.g. 'no-console: error' (repeatable)
+  --cache               Only lint files changed since the last cached run
+  --cache-location PATH Cache file or directory (default: .rslintcache)
+  --cache-strategy S    How changed files are detected: metadata | content
+  --no-inline-config    Ignore eslint-disable and other directive comments
+  --report-unused-disable-directives
+                        Report disable directives that suppress nothing as errors
+  --report-unused-disable-directives-severity LEVEL
+                        Report them at LEVEL: off | warn | error | 0 | 1 | 2
   -h, --help            Show help
 `
 
@@ -397,6 +418,13 @@ func isBroadProjectLoadScope(
 	return false
 }
 
+// registeredRuleMeta looks up the metadata of a rule in the global registry,
+// for report formats that link diagnostics to rule documentation.
+func registeredRuleMeta(name string) (rule.RuleMeta, bool) {
+	ruleImpl, exists := rslintconfig.GlobalRuleRegistry.GetRule(name)
+	return ruleImpl.Meta, exists
+}
+
 func cloneConfigMap(configMap map[string]rslintconfig.RslintConfig) map[string]rslintconfig.RslintConfig {
 	if configMap == nil {
 		return nil
@@ -525,12 +553,41 @@ func parseLintFlags(argv []string) (args lintArgs, help bool, fatalExitCode int)
 	fs.BoolVar(&args.SingleThreaded, "singleThreaded", false, "run in single threaded mode")
 	fs.Int64Var(&args.StartTimeMs, "start-time", 0, "internal: epoch milliseconds from Node.js entry point")
 	fs.Var(&ruleFlags, "rule", "rule override, e.g. 'no-console: error' (repeatable)")
+	fs.BoolVar(&args.Cache, "cache", false, "only lint files changed since the last cached run")
+	fs.StringVar(&args.CacheLocation, "cache-location", "", "path to the cache file or directory")
+	fs.StringVar(&args.CacheStrategy, "cache-strategy", "metadata", "strategy used to detect changed files: metadata or content")
+	fs.BoolVar(&args.NoInlineConfig, "no-inline-config", false, "ignore directive comments such as eslint-disable")
+	var reportUnusedDirectives bool
+	fs.BoolVar(&reportUnusedDirectives, "report-unused-disable-directives", false, "report disable directives that suppress nothing as errors")
+	var unusedDirectiveSeverity string
+	fs.StringVar(&unusedDirectiveSeverity, "report-unused-disable-directives-severity", "", "severity of unused disable directives: off, warn or error")
 
 	if err := fs.Parse(argv); err != nil {
 		// ContinueOnError: fs already printed the diagnostic to stderr.
 		return args, help, 2
 	}
 	args.RuleFlags = []string(ruleFlags)
+	if _, err := lintcache.ParseStrategy(args.CacheStrategy); err != nil {
+		fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		return args, help, 2
+	}
+	if reportUnusedDirectives {
+		args.OverrideUnusedDisableDirectives = true
+		args.UnusedDisableDirectiveSeverity = rule.SeverityError
+	}
+	if unusedDirectiveSeverity != "" {
+		if reportUnusedDirectives {
+			fmt.Fprintln(os.Stderr, "error: --report-unused-disable-directives and --report-unused-disable-directives-severity cannot be used together")
+			return args, help, 2
+		}
+		severity, ok := rule.ParseSeverityLevel(unusedDirectiveSeverity)
+		if !ok {
+			fmt.Fprintf(os.Stderr, "error: invalid --report-unused-disable-directives-severity %q (expected off, warn, error, 0, 1 or 2)\n", unusedDirectiveSeverity)
+			return args, help, 2
+		}
+		args.OverrideUnusedDisableDirectives = true
+		args.UnusedDisableDirectiveSeverity = severity
+	}
 
 	// The Node.js entry point fills in the default "all" when the user
 	// passes a bare --timing, so the value is always present here.
@@ -825,6 +882,15 @@ func executeLintPipeline(args lintArgs, ctx context.Context, dispatch linter.Esl
 		}
 		return 1
 	}
+	// Deprecated rules still run; the warning only points at their
+	// replacements.
+	resolvedConfigs := []rslintconfig.RslintConfig{rslintConfig}
+	if configMap != nil {
+		resolvedConfigs = slices.Collect(maps.Values(configMap))
+	}
+	for _, warning := range rslintconfig.DeprecatedRuleWarnings(rslintconfig.GlobalRuleRegistry, resolvedConfigs...) {
+		fmt.Fprintf(os.Stderr, "warning: %s\n", warning)
+	}
 
 	// Use CWD for display paths (not any config directory).
 	// In multi-config mode, currentDirectory was never reassigned from os.Getwd(),
@@ -858,6 +924,7 @@ func executeLintPipeline(args lintArgs, ctx context.Context, dispatch linter.Esl
 		loadedPrograms         loader.LoadResult
 		targetsByProgram       [][]string
 		lintTargetBySourcePath map[string]rslintconfig.DiscoveredLintTarget
+		lintCache              *lintCacheRun
 	)
 	// --type-check-only is program-wide and pays no lint-target discovery,
 	// target binding/parsing, config-resolution, or Program-loading cost.
@@ -877,6 +944,33 @@ func executeLintPipeline(args lintArgs, ctx context.Context, dispatch linter.Esl
 			fmt.Fprintf(os.Stderr, "error: %v\n", err)
 			return 1
 		}
+		// --cache replays every unchanged target's stored result and narrows the
+		// plan to the rest before any Program is built, so a fully cached run
+		// parses nothing. Program-wide type checking reports diagnostics for
+		// files outside the target set and is never cached.
+		if args.Cache {
+			if typeCheck {
+				fmt.Fprintln(os.Stderr, "warning: --cache is ignored with --type-check")
+			} else {
+				lintCache, err = openLintCache(args.CacheLocation, args.CacheStrategy, workingDirectory, fix, fs)
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "error: %v\n", err)
+					return 1
+				}
+				if args.OverrideUnusedDisableDirectives {
+					lintCache.unusedDirectives = args.UnusedDisableDirectiveSeverity.String()
+				}
+				lintCache.noInlineConfig = args.NoInlineConfig
+				targetPlan.Targets = lintCache.partition(targetPlan.Targets, newLintConfigResolver(lintConfigResolverOptions{
+					ConfigMap:        configMap,
+					Config:           rslintConfig,
+					CurrentDirectory: currentDirectory,
+					EnforcePlugins:   usesJSConfig,
+					TargetPlan:       &targetPlan,
+					FS:               fs,
+				}))
+			}
+		}
 		if !buildAllPrograms {
 			if configMap != nil {
 				programConfigMap = targetPlan.ActiveConfigs(configMap)
@@ -988,20 +1082,27 @@ func executeLintPipeline(args lintArgs, ctx context.Context, dispatch linter.Esl
 	if !typeCheckOnly {
 		rulesForFile = getRulesForFile
 	}
+	getEnvironmentForFile := func(sourceFile *ast.SourceFile) *rule.RuleEnvironment {
+		return fileConfigResolver.EnvironmentForFile(sourceFile.FileName())
+	}
 
 	nativeEditDemand := rule.EditDemandNone
 	if fix {
 		nativeEditDemand = rule.EditDemandAutofix
 	}
 	runOpts := linter.RunLinterOptions{
-		Programs:        programs,
-		SingleThreaded:  singleThreaded,
-		Cwd:             cwd,
-		Scope:           linter.FileScope{Files: allowFiles, Dirs: allowDirs},
-		TargetFiles:     targetsByProgram,
-		GetRulesForFile: rulesForFile,
-		TypeCheck:       typeCheck,
-		Timing:          timingCollector,
+		Programs:                        programs,
+		SingleThreaded:                  singleThreaded,
+		Cwd:                             cwd,
+		Scope:                           linter.FileScope{Files: allowFiles, Dirs: allowDirs},
+		TargetFiles:                     targetsByProgram,
+		GetRulesForFile:                 rulesForFile,
+		GetEnvironmentForFile:           getEnvironmentForFile,
+		TypeCheck:                       typeCheck,
+		Timing:                          timingCollector,
+		NoInlineConfig:                  args.NoInlineConfig,
+		OverrideUnusedDisableDirectives: args.OverrideUnusedDisableDirectives,
+		UnusedDisableDirectiveSeverity:  args.UnusedDisableDirectiveSeverity,
 		Consumer: rule.DiagnosticConsumer{
 			Demand: nativeEditDemand,
 			Report: func(d rule.RuleDiagnostic) {
@@ -1028,7 +1129,7 @@ func executeLintPipeline(args lintArgs, ctx context.Context, dispatch linter.Esl
 	}
 	var pluginCh <-chan []rule.RuleDiagnostic
 	if hasEslintPlugins {
-		pluginInputs := buildPluginFileInputs(runOpts.PreparedPlan, pluginResolver)
+		pluginInputs := buildPluginFileInputs(runOpts.PreparedPlan, pluginResolver, args.NoInlineConfig)
 		pluginCh = dispatchPluginLintAsync(ctx, dispatch, pluginInputs, fix, pluginSuggestionsMode(fix), timingCollector)
 	}
 
@@ -1041,6 +1142,9 @@ func executeLintPipeline(args lintArgs, ctx context.Context, dispatch linter.Esl
 	}
 
 	lintedfileCount := lintResult.LintedFileCount
+	if lintCache != nil {
+		lintedfileCount += int32(lintCache.hitFiles)
+	}
 
 	wg.Wait()
 	// Merge eslint-plugin diagnostics (dispatched in parallel) now that the
@@ -1049,6 +1153,9 @@ func executeLintPipeline(args lintArgs, ctx context.Context, dispatch linter.Esl
 		allDiags = append(allDiags, (<-pluginCh)...)
 	}
 	remapDiagnosticTargetPaths(allDiags, lintTargetBySourcePath, fs)
+	// The plan and binding that produced the final diagnostics; the --fix loop
+	// replaces them with every re-lint pass.
+	finalPlan, finalLintTargetBySourcePath := preparedPlan, lintTargetBySourcePath
 
 	// Emit per-file warnings for CLI-specified files that won't be linted.
 	// Distinguishes "not found on disk" vs "ignored by pattern", aligned
@@ -1118,6 +1225,9 @@ func executeLintPipeline(args lintArgs, ctx context.Context, dispatch linter.Esl
 			if !typeCheckOnly {
 				fixRulesForFile = fixGetRulesForFile
 			}
+			fixGetEnvironmentForFile := func(sourceFile *ast.SourceFile) *rule.RuleEnvironment {
+				return fixConfigResolver.EnvironmentForFile(sourceFile.FileName())
+			}
 			passEditDemand := rule.EditDemandAutofix
 			if pass == maxFixPasses {
 				// This pass only verifies the bytes written by the final
@@ -1133,14 +1243,18 @@ func executeLintPipeline(args lintArgs, ctx context.Context, dispatch linter.Esl
 			)
 			passDiags = append(passDiags, fixSyntaxDiagnostics...)
 			fixRunOpts := linter.RunLinterOptions{
-				Programs:        newBinding.Programs,
-				SingleThreaded:  singleThreaded,
-				Cwd:             cwd,
-				Scope:           linter.FileScope{Files: allowFiles, Dirs: allowDirs},
-				TargetFiles:     fixTargetsByProgram,
-				GetRulesForFile: fixRulesForFile,
-				TypeCheck:       typeCheck,
-				Timing:          timingCollector,
+				Programs:                        newBinding.Programs,
+				SingleThreaded:                  singleThreaded,
+				Cwd:                             cwd,
+				Scope:                           linter.FileScope{Files: allowFiles, Dirs: allowDirs},
+				TargetFiles:                     fixTargetsByProgram,
+				GetRulesForFile:                 fixRulesForFile,
+				GetEnvironmentForFile:           fixGetEnvironmentForFile,
+				TypeCheck:                       typeCheck,
+				Timing:                          timingCollector,
+				NoInlineConfig:                  args.NoInlineConfig,
+				OverrideUnusedDisableDirectives: args.OverrideUnusedDisableDirectives,
+				UnusedDisableDirectiveSeverity:  args.UnusedDisableDirectiveSeverity,
 				Consumer: rule.DiagnosticConsumer{
 					Demand: passEditDemand,
 					Report: func(d rule.RuleDiagnostic) {
@@ -1164,7 +1278,7 @@ func executeLintPipeline(args lintArgs, ctx context.Context, dispatch linter.Esl
 			if hasEslintPlugins {
 				fixPluginInputs := buildPluginFileInputs(fixRunOpts.PreparedPlan, pluginConfigResolver{
 					lintResolver: fixConfigResolver,
-				})
+				}, args.NoInlineConfig)
 				fixPluginCh = dispatchPluginLintAsync(ctx, dispatch, fixPluginInputs, fix, pluginSuggestionsMode(fix), timingCollector)
 			}
 			passResult, passErr := linter.RunLinter(fixRunOpts)
@@ -1188,6 +1302,7 @@ func executeLintPipeline(args lintArgs, ctx context.Context, dispatch linter.Esl
 
 			// Replace allDiags with latest post-fix diagnostics.
 			allDiags = passDiags
+			finalPlan, finalLintTargetBySourcePath = fixPreparedPlan, fixLintTargetBySourcePath
 			if pass == maxFixPasses {
 				// The maximum number of write passes has already run (the initial
 				// pass plus maxFixPasses-1 loop passes). This extra pass is the
@@ -1211,6 +1326,15 @@ func executeLintPipeline(args lintArgs, ctx context.Context, dispatch linter.Esl
 	}
 
 	allDiags = deduplicateTypeScriptDiagnostics(allDiags, fs, targetPlan.PreferredCallerPaths())
+	if lintCache != nil {
+		// An interrupted run may have skipped files mid-plan; recording it
+		// would cache their partial results as complete.
+		if ctx.Err() == nil {
+			lintCache.record(finalPlan, finalLintTargetBySourcePath, allDiags)
+			lintCache.save()
+		}
+		allDiags = lintCache.replay(allDiags, lintResult.ExecutedRules)
+	}
 
 	// Diagnostics arrive in completion order — programs and, within a
 	// program, file shards run in parallel — so impose a deterministic
@@ -1267,17 +1391,23 @@ func executeLintPipeline(args lintArgs, ctx context.Context, dispatch linter.Esl
 		FixedIssues:      fixedCount,
 		StartedAt:        timeBefore,
 	})
+	var registeredRules []string
+	if format == output.FormatSARIF {
+		registeredRules = slices.Sorted(maps.Keys(rslintconfig.GlobalRuleRegistry.GetAllRules()))
+	}
 	if err := output.Render(os.Stdout, report, output.Options{
 		Format:       format,
 		ComparePaths: comparePathOptions,
 		Quiet:        quiet,
 		ColorEnabled: colorEnabled,
+		Rules:        registeredRules,
+		RuleMeta:     registeredRuleMeta,
 	}); err != nil {
 		fmt.Fprintf(os.Stderr, "error writing lint report: %v\n", err)
 		return 1
 	}
 	// The timing table goes to stderr so machine-readable stdout formats
-	// (jsonline/github/gitlab) stay parseable with --timing enabled.
+	// (jsonline/github/gitlab/sarif) stay parseable with --timing enabled.
 	if timingCollector != nil {
 		table := output.FormatRuleTimingTable(timingCollector.Timings(), timingLimit)
 		if args.DeferTimingTable != nil {
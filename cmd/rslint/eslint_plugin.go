@@ -42,8 +42,9 @@ func (r pluginConfigResolver) resolve(filePath string) (wireKey string, merged *
 // buildPluginFileInputs projects third-party plugin inputs from the same
 // prepared file/rule plan consumed by native linting. Each target's already-
 // loaded *ast.SourceFile is reused as the rebuild frame, so Go never re-reads
-// or re-decodes the file.
-func buildPluginFileInputs(plan *linter.LintPlan, resolver pluginConfigResolver) []linter.EslintPluginFileInput {
+// or re-decodes the file. noInlineConfig carries --no-inline-config, which
+// overrides each file's linterOptions.
+func buildPluginFileInputs(plan *linter.LintPlan, resolver pluginConfigResolver, noInlineConfig bool) []linter.EslintPluginFileInput {
 	targets := plan.Targets()
 	if len(targets) == 0 {
 		return nil
@@ -65,6 +66,9 @@ func buildPluginFileInputs(plan *linter.LintPlan, resolver pluginConfigResolver)
 		if !ok {
 			continue
 		}
+		if noInlineConfig {
+			input.NoInlineConfig = true
+		}
 		inputs = append(inputs, input)
 	}
 	return inputs
@@ -0,0 +1,305 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"runtime/debug"
+	"slices"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/vfs"
+	rslintconfig "github.com/web-infra-dev/rslint/internal/config"
+	"github.com/web-infra-dev/rslint/internal/lintcache"
+	"github.com/web-infra-dev/rslint/internal/linter"
+	"github.com/web-infra-dev/rslint/internal/program"
+	"github.com/web-infra-dev/rslint/internal/rule"
+)
+
+// lintCacheRun binds one --cache run to its cache file. It is driven from the
+// pipeline goroutine only: partition before Programs are built, replay after
+// the fix loop, record and save once the final diagnostics are known.
+type lintCacheRun struct {
+	cache *lintcache.Cache
+	path  string
+	fix   bool
+	fsys  vfs.FS
+
+	// configByTarget holds the fingerprint computed for every planned target,
+	// keyed by target path, so results are recorded under exactly the key they
+	// were looked up with.
+	configByTarget map[string]string
+
+	// unusedDirectives is the severity the command line forces unused disable
+	// directives to be reported at, empty when each file's config decides.
+	// Like noInlineConfig, it changes what a clean file reports.
+	unusedDirectives string
+	noInlineConfig   bool
+
+	hits     []rule.RuleDiagnostic
+	hitRules map[string]struct{}
+	hitFiles int
+
+	// recordedSources holds every dependency whose closure is already in the
+	// file table, so shared imports are walked once per run.
+	recordedSources map[*ast.SourceFile]struct{}
+
+	// programDependencies memoizes the dependencies every file of a Program
+	// shares, recorded once per Program.
+	programDependencies map[*program.Program]programDependencies
+}
+
+// programDependencies is what a Program's type information depends on beyond
+// a file's own imports: its tsconfig and the declaration files it includes.
+type programDependencies struct {
+	files []string
+	ok    bool
+}
+
+func openLintCache(location string, strategyName string, cwd string, fix bool, fsys vfs.FS) (*lintCacheRun, error) {
+	strategy, err := lintcache.ParseStrategy(strategyName)
+	if err != nil {
+		return nil, err
+	}
+	path := lintcache.ResolveLocation(location, cwd)
+	return &lintCacheRun{
+		cache:           lintcache.Load(path, lintCacheVersion(), strategy, fsys),
+		path:            path,
+		fix:             fix,
+		fsys:            fsys,
+		configByTarget:  make(map[string]string),
+		hitRules:        make(map[string]struct{}),
+		recordedSources: make(map[*ast.SourceFile]struct{}),
+
+		programDependencies: make(map[*program.Program]programDependencies),
+	}, nil
+}
+
+// lintCacheVersion identifies the rslint build that wrote a cache. Release
+// builds carry a module version or VCS revision; a local development build
+// has neither, so its executable's size and modification time stand in —
+// every rebuild then starts a fresh cache, as a changed rule implementation
+// must.
+func lintCacheVersion() string {
+	var version string
+	if info, ok := debug.ReadBuildInfo(); ok {
+		version = info.Main.Version
+		for _, setting := range info.Settings {
+			switch setting.Key {
+			case "vcs.revision", "vcs.modified":
+				version += " " + setting.Value
+			}
+		}
+		if info.Main.Version != "" && info.Main.Version != "(devel)" {
+			return version
+		}
+	}
+	if executable, err := os.Executable(); err == nil {
+		if stat, err := os.Stat(executable); err == nil {
+			version += fmt.Sprintf(" %d %d", stat.Size(), stat.ModTime().UnixNano())
+		}
+	}
+	return version
+}
+
+// lintCacheFingerprint is the configuration half of a result's key: the merged
+// config entry shape the file resolved to, the rules it enables and the
+// run-wide options that add diagnostics of their own.
+type lintCacheFingerprint struct {
+	Config           *rslintconfig.MergedConfig `json:"config"`
+	Rules            []string                   `json:"rules"`
+	UnusedDirectives string                     `json:"unusedDirectives,omitempty"`
+	NoInlineConfig   bool                       `json:"noInlineConfig,omitempty"`
+}
+
+// partition replays every target whose cached result is still valid and
+// returns the targets that must be linted. Replayed diagnostics are held until
+// replay so the --fix loop never sees them.
+//
+// Targets that enable ESLint plugin rules are always linted and never
+// recorded: a plugin's implementation lives in node_modules, outside the
+// rslint build the cache version identifies.
+func (r *lintCacheRun) partition(targets []rslintconfig.DiscoveredLintTarget, resolver *lintConfigResolver) []rslintconfig.DiscoveredLintTarget {
+	misses := make([]rslintconfig.DiscoveredLintTarget, 0, len(targets))
+	for _, target := range targets {
+		resolved, ok := resolver.resolveTarget(target)
+		if !ok || slices.ContainsFunc(resolved.EnabledRules, isEslintPluginRule) {
+			misses = append(misses, target)
+			continue
+		}
+		fingerprint := lintCacheFingerprint{
+			Config:           resolved.MergedConfig,
+			UnusedDirectives: r.unusedDirectives,
+			NoInlineConfig:   r.noInlineConfig,
+		}
+		for _, configuredRule := range resolved.EnabledRules {
+			fingerprint.Rules = append(fingerprint.Rules, configuredRule.Name+":"+configuredRule.Severity.String())
+		}
+		key, err := lintcache.Fingerprint(fingerprint)
+		if err != nil {
+			misses = append(misses, target)
+			continue
+		}
+		r.configByTarget[target.Path] = key
+		hit, ok := r.cache.Lookup(target.Path, key, r.fix)
+		if !ok {
+			misses = append(misses, target)
+			continue
+		}
+		r.hits = append(r.hits, hit.Diagnostics...)
+		for _, name := range hit.Rules {
+			r.hitRules[name] = struct{}{}
+		}
+		r.hitFiles++
+	}
+	return misses
+}
+
+func isEslintPluginRule(configuredRule rule.ConfiguredRule) bool {
+	return configuredRule.IsEslintPluginRule
+}
+
+// replay merges the replayed results into the run's diagnostics and executed
+// rule set.
+func (r *lintCacheRun) replay(diags []rule.RuleDiagnostic, executedRules map[string]struct{}) []rule.RuleDiagnostic {
+	for name := range r.hitRules {
+		executedRules[name] = struct{}{}
+	}
+	return append(diags, r.hits...)
+}
+
+// record stores the result of every file the final lint pass planned. diags
+// must be the run's final diagnostics, already remapped to target paths.
+func (r *lintCacheRun) record(
+	plan *linter.LintPlan,
+	lintTargetBySourcePath map[string]rslintconfig.DiscoveredLintTarget,
+	diags []rule.RuleDiagnostic,
+) {
+	byPath := groupDiagsByFile(diags)
+	for _, planned := range plan.Files() {
+		targetPath := planned.File.FileName()
+		if target, ok := lookupLintTarget(lintTargetBySourcePath, targetPath, r.fsys); ok {
+			targetPath = target.Path
+		}
+		config, ok := r.configByTarget[targetPath]
+		if !ok {
+			continue
+		}
+		rules := make([]string, 0, len(planned.Rules))
+		for _, configuredRule := range planned.Rules {
+			rules = append(rules, configuredRule.Name)
+		}
+		shared := r.recordProgramDependencies(planned.Program)
+		if !shared.ok {
+			continue
+		}
+		r.cache.RecordResult(lintcache.Record{
+			Path:         targetPath,
+			Text:         planned.File.Text(),
+			Config:       config,
+			Fixed:        r.fix,
+			Rules:        rules,
+			Diagnostics:  byPath[targetPath],
+			Dependencies: append(r.recordDependencies(planned.Program, planned.File), shared.files...),
+		})
+	}
+}
+
+// recordProgramDependencies records what every file of prog depends on
+// without importing it. Compiler options and ambient declarations change type
+// information, so a tsconfig Program's config is recorded with its extended
+// configs and every declaration file of the Program as dependencies, and each
+// file depends on the config. A Program without a tsconfig depends on its
+// declaration files directly. The result is not ok when a config cannot be
+// read; such files are not cached.
+func (r *lintCacheRun) recordProgramDependencies(prog *program.Program) programDependencies {
+	if recorded, ok := r.programDependencies[prog]; ok {
+		return recorded
+	}
+	var declarations []*ast.SourceFile
+	for _, file := range prog.SourceFiles() {
+		if file.IsDeclarationFile && !prog.IsSourceFileDefaultLibrary(file) {
+			declarations = append(declarations, file)
+		}
+	}
+	recorded := programDependencies{ok: true}
+	configs := prog.ConfigFileNames()
+	if len(configs) == 0 {
+		recorded.files = r.recordSources(prog, declarations)
+	} else {
+		for _, config := range configs {
+			text, ok := r.fsys.ReadFile(config)
+			if !ok {
+				recorded.ok = false
+				break
+			}
+			var dependencies []string
+			if config == configs[0] {
+				dependencies = append(slices.Clone(configs[1:]), r.recordSources(prog, declarations)...)
+			}
+			r.cache.RecordFile(config, text, dependencies)
+		}
+		recorded.files = configs[:1]
+	}
+	r.programDependencies[prog] = recorded
+	return recorded
+}
+
+// recordDependencies records the import closure of file in the file table and
+// returns its direct dependencies.
+func (r *lintCacheRun) recordDependencies(prog *program.Program, file *ast.SourceFile) []string {
+	return r.recordSources(prog, moduleDependencies(prog, file))
+}
+
+// recordSources records the import closures of files in the file table and
+// returns their names. Default libraries are bundled with the build and
+// covered by the cache version; external libraries are recorded as leaves,
+// since their own imports are outside anything a lint run changes.
+func (r *lintCacheRun) recordSources(prog *program.Program, direct []*ast.SourceFile) []string {
+	queue := slices.Clone(direct)
+	for len(queue) > 0 {
+		dependency := queue[0]
+		queue = queue[1:]
+		if _, ok := r.recordedSources[dependency]; ok {
+			continue
+		}
+		r.recordedSources[dependency] = struct{}{}
+		var next []*ast.SourceFile
+		if !prog.IsSourceFileFromExternalLibrary(dependency) {
+			next = moduleDependencies(prog, dependency)
+			queue = append(queue, next...)
+		}
+		r.cache.RecordFile(dependency.FileName(), dependency.Text(), sourceFileNames(next))
+	}
+	return sourceFileNames(direct)
+}
+
+func moduleDependencies(prog *program.Program, file *ast.SourceFile) []*ast.SourceFile {
+	var dependencies []*ast.SourceFile
+	for _, reference := range prog.ModuleGraph().References(file, program.AllModuleReferences) {
+		target := reference.Target
+		if target == nil || target == file || prog.IsSourceFileDefaultLibrary(target) {
+			continue
+		}
+		if !slices.Contains(dependencies, target) {
+			dependencies = append(dependencies, target)
+		}
+	}
+	return dependencies
+}
+
+func sourceFileNames(files []*ast.SourceFile) []string {
+	if len(files) == 0 {
+		return nil
+	}
+	names := make([]string, len(files))
+	for i, file := range files {
+		names[i] = file.FileName()
+	}
+	return names
+}
+
+func (r *lintCacheRun) save() {
+	if err := r.cache.Save(r.path); err != nil {
+		fmt.Fprintf(os.Stderr, "warning: failed to write lint cache %s: %v\n", r.path, err)
+	}
+}
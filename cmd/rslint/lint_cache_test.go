@@ -0,0 +1,147 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/microsoft/typescript-go/shim/bundled"
+	"github.com/microsoft/typescript-go/shim/tspath"
+	"github.com/microsoft/typescript-go/shim/vfs/cachedvfs"
+	"github.com/microsoft/typescript-go/shim/vfs/osvfs"
+	rslintconfig "github.com/web-infra-dev/rslint/internal/config"
+	"github.com/web-infra-dev/rslint/internal/lintcache"
+)
+
+func TestExecuteLintPipelineCacheReplaysUnchangedFiles(t *testing.T) {
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "rslint.jsonc")
+	for path, content := range map[string]string{
+		configPath:                     `[{"rules":{"no-debugger":"error"}}]`,
+		filepath.Join(dir, "index.js"): "debugger;\n",
+		filepath.Join(dir, "clean.js"): "export const clean = 1;\n",
+	} {
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatalf("write %s: %v", path, err)
+		}
+	}
+	run := func(fsys *commandReadCountingFS) (int, string, string) {
+		return runLintPipelineForTest(t, dir, lintArgs{
+			Config:         configPath,
+			FS:             fsys,
+			Format:         "jsonline",
+			NoColor:        true,
+			SingleThreaded: true,
+			Cache:          true,
+			CacheStrategy:  "metadata",
+		})
+	}
+	newFS := func() *commandReadCountingFS {
+		return &commandReadCountingFS{
+			FS:    bundled.WrapFS(cachedvfs.From(osvfs.FS())),
+			reads: make(map[string]int),
+		}
+	}
+
+	firstCode, firstStdout, stderr := run(newFS())
+	if firstCode != 1 || firstStdout == "" {
+		t.Fatalf("first run: code=%d stdout=%q stderr=%q", firstCode, firstStdout, stderr)
+	}
+	if _, err := os.Stat(filepath.Join(dir, lintcache.DefaultFileName)); err != nil {
+		t.Fatalf("cache file was not written: %v", err)
+	}
+
+	fsys := newFS()
+	code, stdout, stderr := run(fsys)
+	if code != firstCode || stdout != firstStdout {
+		t.Fatalf("cached run differs: code=%d stdout=%q stderr=%q, want code=%d stdout=%q", code, stdout, stderr, firstCode, firstStdout)
+	}
+	if got := fsys.readCount(tspath.ResolvePath(tspath.NormalizePath(dir), "clean.js")); got != 0 {
+		t.Fatalf("unchanged clean file was read %d time(s)", got)
+	}
+
+	if err := os.WriteFile(configPath, []byte(`[{"rules":{"no-debugger":"off"}}]`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	code, stdout, stderr = run(newFS())
+	if code != 0 || stdout != "" {
+		t.Fatalf("run after config change replayed stale results: code=%d stdout=%q stderr=%q", code, stdout, stderr)
+	}
+}
+
+func TestExecuteLintPipelineCacheTracksTsconfigAndDeclarations(t *testing.T) {
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "rslint.jsonc")
+	tsconfigPath := filepath.Join(dir, "tsconfig.json")
+	write := func(path string, content string) {
+		t.Helper()
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatalf("write %s: %v", path, err)
+		}
+	}
+	write(configPath, `[{
+		"files": ["index.ts"],
+		"languageOptions": { "parserOptions": { "project": ["./tsconfig.json"] } },
+		"rules": { "@typescript-eslint/no-unsafe-call": "error", "no-undef": "off" }
+	}]`)
+	write(tsconfigPath, `{ "files": ["index.ts", "any.d.ts"] }`)
+	write(filepath.Join(dir, "any.d.ts"), "declare const callback: any;\n")
+	write(filepath.Join(dir, "typed.d.ts"), "declare const callback: () => void;\n")
+	write(filepath.Join(dir, "index.ts"), "callback();\n")
+	run := func() (int, string) {
+		code, stdout, stderr := runLintPipelineForTest(t, dir, lintArgs{
+			Config:         configPath,
+			FS:             bundled.WrapFS(cachedvfs.From(osvfs.FS())),
+			Format:         "jsonline",
+			NoColor:        true,
+			SingleThreaded: true,
+			Cache:          true,
+			CacheStrategy:  "content",
+		})
+		return code, stdout + stderr
+	}
+
+	if code, output := run(); code != 1 {
+		t.Fatalf("first run: code=%d, want 1\n%s", code, output)
+	}
+	write(tsconfigPath, `{ "files": ["index.ts", "typed.d.ts"] }`)
+	if code, output := run(); code != 0 {
+		t.Fatalf("run after tsconfig change: code=%d, want 0\n%s", code, output)
+	}
+	write(filepath.Join(dir, "typed.d.ts"), "declare const callback: any;\n")
+	if code, output := run(); code != 1 {
+		t.Fatalf("run after declaration change: code=%d, want 1\n%s", code, output)
+	}
+}
+
+func TestLintCachePartitionSkipsPluginRuleTargets(t *testing.T) {
+	rslintconfig.RegisterAllRules()
+	rslintconfig.RegisterEslintPluginRules([]rslintconfig.EslintPluginEntry{{Prefix: "cacheplug", RuleNames: []string{"x"}}})
+	dir := t.TempDir()
+	fsys := bundled.WrapFS(cachedvfs.From(osvfs.FS()))
+	cacheRun, err := openLintCache("", "metadata", dir, false, fsys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resolver := newLintConfigResolver(lintConfigResolverOptions{
+		Config: rslintconfig.RslintConfig{
+			{Files: []string{"plugin.js"}, Rules: rslintconfig.Rules{"cacheplug/x": "error"}},
+			{Rules: rslintconfig.Rules{"no-debugger": "error"}},
+		},
+		CurrentDirectory: dir,
+		FS:               fsys,
+	})
+	pluginTarget := filepath.Join(dir, "plugin.js")
+	nativeTarget := filepath.Join(dir, "native.js")
+
+	misses := cacheRun.partition([]rslintconfig.DiscoveredLintTarget{{Path: pluginTarget}, {Path: nativeTarget}}, resolver)
+	if len(misses) != 2 {
+		t.Fatalf("misses = %v, want both targets on an empty cache", misses)
+	}
+	if _, ok := cacheRun.configByTarget[pluginTarget]; ok {
+		t.Error("a target with plugin rules was keyed for recording")
+	}
+	if _, ok := cacheRun.configByTarget[nativeTarget]; !ok {
+		t.Error("a target with native rules only was not keyed for recording")
+	}
+}
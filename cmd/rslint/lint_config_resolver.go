@@ -153,6 +153,19 @@ func (r *lintConfigResolver) resolveFile(
 	return r.currentDirectory, r.singleResolver.ResolveTarget(target), true
 }
 
+// resolveTarget resolves a discovered target directly, before any Program has
+// bound it to a source file.
+func (r *lintConfigResolver) resolveTarget(target rslintconfig.DiscoveredLintTarget) (rslintconfig.ResolvedFileConfig, bool) {
+	if r.configMap == nil {
+		return r.singleResolver.ResolveTarget(target), true
+	}
+	resolver := r.configResolver(target.ConfigDirectory)
+	if resolver == nil {
+		return rslintconfig.ResolvedFileConfig{}, false
+	}
+	return resolver.ResolveTarget(target), true
+}
+
 func (r *lintConfigResolver) ConfigForFile(filePath string) *rslintconfig.MergedConfig {
 	_, resolved, ok := r.resolveFile(filePath)
 	if !ok {
@@ -171,3 +184,13 @@ func (r *lintConfigResolver) EnabledRulesForFile(filePath string) []rule.Configu
 	}
 	return resolved.EnabledRules
 }
+
+// EnvironmentForFile returns the rule environment of the file's merged config,
+// which exists even when that config enables no rule.
+func (r *lintConfigResolver) EnvironmentForFile(filePath string) *rule.RuleEnvironment {
+	_, resolved, ok := r.resolveFile(filePath)
+	if !ok {
+		return nil
+	}
+	return resolved.Environment
+}
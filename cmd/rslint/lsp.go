@@ -2,18 +2,28 @@
 package main
 
 import (
+	"fmt"
 	"os"
 	"runtime"
+	"strings"
 
 	"github.com/microsoft/typescript-go/shim/bundled"
 	"github.com/microsoft/typescript-go/shim/core"
 	"github.com/microsoft/typescript-go/shim/tspath"
 	"github.com/microsoft/typescript-go/shim/vfs/osvfs"
 	"github.com/web-infra-dev/rslint/internal/lsp"
+	"github.com/web-infra-dev/rslint/internal/rule"
 	"github.com/web-infra-dev/rslint/internal/utils"
 )
 
+const unusedDirectiveSeverityFlag = "--report-unused-disable-directives-severity"
+
 func runLSP(args []string) int {
+	unusedDirectiveSeverity, overrideUnusedDirectives, err := lspUnusedDirectiveSeverity(args)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		return 2
+	}
 
 	fs := bundled.WrapFS(osvfs.FS())
 	defaultLibraryPath := bundled.LibPath()
@@ -27,6 +37,9 @@ func runLSP(args []string) int {
 		FS:                 fs,
 		DefaultLibraryPath: defaultLibraryPath,
 		TypingsLocation:    typingsLocation,
+
+		OverrideUnusedDisableDirectives: overrideUnusedDirectives,
+		UnusedDisableDirectiveSeverity:  unusedDirectiveSeverity,
 	})
 
 	if err := s.Run(); err != nil {
@@ -35,6 +48,34 @@ func runLSP(args []string) int {
 	return 0
 }
 
+// lspUnusedDirectiveSeverity reads --report-unused-disable-directives-severity
+// from the --lsp arguments, reporting whether it was given. Editors commonly
+// add transport flags such as --stdio, so every other argument is ignored
+// rather than rejected.
+func lspUnusedDirectiveSeverity(args []string) (rule.DiagnosticSeverity, bool, error) {
+	var severity rule.DiagnosticSeverity
+	set := false
+	for i := 0; i < len(args); i++ {
+		var value string
+		switch {
+		case args[i] == unusedDirectiveSeverityFlag && i+1 < len(args):
+			i++
+			value = args[i]
+		case strings.HasPrefix(args[i], unusedDirectiveSeverityFlag+"="):
+			value = args[i][len(unusedDirectiveSeverityFlag)+1:]
+		default:
+			continue
+		}
+		parsed, ok := rule.ParseSeverityLevel(value)
+		if !ok {
+			return severity, false, fmt.Errorf("invalid %s %q (expected off, warn, error, 0, 1 or 2)", unusedDirectiveSeverityFlag, value)
+		}
+		severity = parsed
+		set = true
+	}
+	return severity, set, nil
+}
+
 func getGlobalTypingsCacheLocation() string {
 	switch runtime.GOOS {
 	case "windows":
@@ -53,6 +53,9 @@ const (
 	KindLint ipc.MessageKind = "lint"
 	// KindGetAstInfo is sent from JS to Go to request AST info at a position.
 	KindGetAstInfo ipc.MessageKind = "getAstInfo"
+	// KindGetRuleMeta is sent from JS to Go to request the metadata of
+	// registered rules.
+	KindGetRuleMeta ipc.MessageKind = "getRuleMeta"
 	// KindPluginLint is sent from Go to JS to run community ESLint plugin rules.
 	KindPluginLint ipc.MessageKind = "pluginLint"
 	// KindLoadConfigs asks a capable Node host to evaluate one Go-discovered
@@ -117,7 +120,14 @@ type LintRequest struct {
 	// computed but NOT written to disk — the JS side (Rslint.outputFixes) writes
 	// it. Diagnostics describe the original input; callers can lint Output again
 	// when they need post-fix diagnostics.
-	Fix                       bool `json:"fix,omitempty"`
+	Fix bool `json:"fix,omitempty"`
+	// ReportUnusedDisableDirectives is the severity ("off", "warn", "error",
+	// or 0-2) at which disable directives that suppress nothing are reported,
+	// with fixes that remove them. It overrides the config's
+	// linterOptions.reportUnusedDisableDirectives; empty leaves it in charge.
+	ReportUnusedDisableDirectives string `json:"reportUnusedDisableDirectives,omitempty"`
+	// NoInlineConfig ignores directive comments whatever linterOptions say.
+	NoInlineConfig            bool `json:"noInlineConfig,omitempty"`
 	IncludeEncodedSourceFiles bool `json:"includeEncodedSourceFiles,omitempty"` // Whether to include encoded source files in response
 }
 
@@ -218,6 +228,30 @@ type Suggestion struct {
 	Fixes     []Fix             `json:"fixes,omitempty"`
 }
 
+// GetRuleMetaRequest asks for the metadata of the named rules. An empty Rules
+// list requests every registered rule.
+type GetRuleMetaRequest struct {
+	Rules []string `json:"rules,omitempty"`
+}
+
+// GetRuleMetaResponse lists the metadata of the requested rules that are
+// registered, sorted by name. Unknown names are omitted.
+type GetRuleMetaResponse struct {
+	Rules []RuleMeta `json:"rules"`
+}
+
+// RuleMeta is the wire form of rule.RuleMeta.
+type RuleMeta struct {
+	Name           string   `json:"name"`
+	Type           string   `json:"type,omitempty"`
+	DocsURL        string   `json:"docsUrl,omitempty"`
+	Fixable        string   `json:"fixable,omitempty"`
+	HasSuggestions bool     `json:"hasSuggestions,omitempty"`
+	Deprecated     bool     `json:"deprecated,omitempty"`
+	ReplacedBy     []string `json:"replacedBy,omitempty"`
+	MessageIds     []string `json:"messageIds,omitempty"`
+}
+
 // Handler defines the interface for handling IPC messages
 type Handler interface {
 	HandleLint(req LintRequest) (*LintResponse, error)
@@ -257,6 +291,12 @@ type BidirectionalHandler interface {
 	HandleLintWithContext(ctx context.Context, req LintRequest, requester Requester) (*LintResponse, error)
 }
 
+// RuleMetaHandler is an optional extension to Handler for hosts that can
+// describe their registered rules.
+type RuleMetaHandler interface {
+	HandleGetRuleMeta(req GetRuleMetaRequest) (*GetRuleMetaResponse, error)
+}
+
 // Service manages bidirectional IPC communication for `--api` mode. Framing,
 // request multiplexing, and the continuously running read loop are delegated
 // to internal/ipc.Channel.
@@ -393,6 +433,20 @@ func (s *Service) handleInbound(ctx context.Context, msg *ipc.Message) (any, err
 		}
 		return s.handler.HandleGetAstInfo(req)
 
+	case KindGetRuleMeta:
+		if !s.handshakeOK {
+			return nil, fmt.Errorf("API protocol handshake required (expected version %s)", Version)
+		}
+		handler, ok := s.handler.(RuleMetaHandler)
+		if !ok {
+			return nil, errors.New("API handler does not support getRuleMeta requests")
+		}
+		var req GetRuleMetaRequest
+		if err := msg.Decode(&req); err != nil {
+			return nil, fmt.Errorf("failed to parse get rule meta request: %w", err)
+		}
+		return handler.HandleGetRuleMeta(req)
+
 	case ipc.KindExit:
 		if s.exitRequested {
 			return struct{}{}, nil
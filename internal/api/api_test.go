@@ -360,6 +360,50 @@ func TestService_LegacyHandlerFallback(t *testing.T) {
 	}
 }
 
+type ruleMetaServiceTestHandler struct {
+	serviceTestHandler
+}
+
+func (h *ruleMetaServiceTestHandler) HandleGetRuleMeta(req GetRuleMetaRequest) (*GetRuleMetaResponse, error) {
+	rules := make([]RuleMeta, 0, len(req.Rules))
+	for _, name := range req.Rules {
+		rules = append(rules, RuleMeta{Name: name, Type: "problem"})
+	}
+	return &GetRuleMetaResponse{Rules: rules}, nil
+}
+
+func TestService_GetRuleMeta(t *testing.T) {
+	pair := newServiceChannelPair(t, &ruleMetaServiceTestHandler{}, nil)
+	ctx := requestContext(t)
+	if _, err := pair.peer.SendRequest(ctx, ipc.KindHandshake, HandshakeRequest{Version: Version}); err != nil {
+		t.Fatalf("handshake: %v", err)
+	}
+	message, err := pair.peer.SendRequest(ctx, KindGetRuleMeta, GetRuleMetaRequest{Rules: []string{"no-debugger"}})
+	if err != nil {
+		t.Fatalf("getRuleMeta: %v", err)
+	}
+	var response GetRuleMetaResponse
+	if err := message.Decode(&response); err != nil {
+		t.Fatalf("decode getRuleMeta: %v", err)
+	}
+	if len(response.Rules) != 1 || response.Rules[0].Name != "no-debugger" || response.Rules[0].Type != "problem" {
+		t.Fatalf("unexpected getRuleMeta response: %+v", response)
+	}
+	_, _ = pair.peer.SendRequest(ctx, ipc.KindExit, struct{}{})
+}
+
+func TestService_GetRuleMetaRequiresSupportingHandler(t *testing.T) {
+	pair := newServiceChannelPair(t, &serviceTestHandler{}, nil)
+	ctx := requestContext(t)
+	if _, err := pair.peer.SendRequest(ctx, ipc.KindHandshake, HandshakeRequest{Version: Version}); err != nil {
+		t.Fatalf("handshake: %v", err)
+	}
+	if _, err := pair.peer.SendRequest(ctx, KindGetRuleMeta, GetRuleMetaRequest{}); err == nil {
+		t.Fatal("getRuleMeta should be rejected by a handler without rule metadata")
+	}
+	_, _ = pair.peer.SendRequest(ctx, ipc.KindExit, struct{}{})
+}
+
 func TestService_TransportShutdownCancelsReverseRequest(t *testing.T) {
 	reverseStarted := make(chan struct{})
 	handler := &reverseServiceTestHandler{reverseErr: make(chan error, 1)}
@@ -691,3 +691,38 @@ func createGapFileProgram(t *testing.T, sourceFiles map[string]string) *compiler
 	}
 	return program
 }
+
+// TestAllRules_DeclareMeta pins that every native rule describes itself to
+// tooling: a known type, a docs page, and replacements that exist.
+func TestAllRules_DeclareMeta(t *testing.T) {
+	RegisterAllRules()
+	registry := GlobalRuleRegistry.GetAllRules()
+	keys := make([]string, 0, len(registry))
+	for k := range registry {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		meta := registry[key].Meta
+		if registry[key].IsEslintPluginRule {
+			continue
+		}
+		switch meta.Type {
+		case rule.RuleTypeProblem, rule.RuleTypeSuggestion, rule.RuleTypeLayout:
+		default:
+			t.Errorf("%s: Meta.Type = %q, want problem, suggestion, or layout", key, meta.Type)
+		}
+		if meta.DocsURL != rule.DocsURL(key) {
+			t.Errorf("%s: Meta.DocsURL = %q, want %q", key, meta.DocsURL, rule.DocsURL(key))
+		}
+		for _, replacement := range meta.ReplacedBy {
+			if _, ok := registry[replacement]; !ok {
+				t.Errorf("%s: replacement %q is not a registered rule", key, replacement)
+			}
+		}
+		if len(meta.ReplacedBy) > 0 && !meta.Deprecated {
+			t.Errorf("%s: ReplacedBy is set on a rule that is not deprecated", key)
+		}
+	}
+}
@@ -41,9 +41,10 @@ type ConfigEntry struct {
 	// `"rules": null` changes flat-config object-shape semantics when the JSON
 	// is decoded again: an ignores-only global entry becomes an entry-local
 	// ignore. Authored `rules: null` is still preserved as non-global on decode.
-	Rules    Rules    `json:"rules,omitempty"`
-	Plugins  []string `json:"plugins,omitempty"`
-	Settings Settings `json:"settings,omitempty"`
+	Rules         Rules          `json:"rules,omitempty"`
+	Plugins       []string       `json:"plugins,omitempty"`
+	Settings      Settings       `json:"settings,omitempty"`
+	LinterOptions *LinterOptions `json:"linterOptions,omitempty"`
 
 	// collectedGitignore marks the process-local synthetic entry prepended by
 	// ConfigWithGitignore and retains its once-compiled directory-node
@@ -236,6 +237,9 @@ func (config *RslintConfig) UnmarshalJSON(data []byte) error {
 		if err := validateConfigRules(decoded.Rules); err != nil {
 			return fmt.Errorf("config entry at index %d: %w", index, err)
 		}
+		if err := decoded.LinterOptions.validate(); err != nil {
+			return fmt.Errorf("config entry at index %d: %w", index, err)
+		}
 		// Global-ignore semantics depend on object shape, not on whether a
 		// present field decodes to a non-nil Go value. Preserve the non-global
 		// shape of entries such as {ignores, rules: null} or entries carrying a
@@ -248,7 +252,7 @@ func (config *RslintConfig) UnmarshalJSON(data []byte) error {
 				break
 			}
 		}
-		if hasNonGlobalKey && decoded.Files == nil && decoded.FilePatternGroups == nil && decoded.Rules == nil && decoded.Plugins == nil && decoded.Settings == nil && decoded.LanguageOptions == nil {
+		if hasNonGlobalKey && decoded.Files == nil && decoded.FilePatternGroups == nil && decoded.Rules == nil && decoded.Plugins == nil && decoded.Settings == nil && decoded.LanguageOptions == nil && decoded.LinterOptions == nil {
 			decoded.Settings = Settings{}
 		}
 		entries = append(entries, ConfigEntry(decoded))
@@ -393,6 +397,95 @@ func validateConfigRules(rules Rules) error {
 	return nil
 }
 
+// LinterOptions is the flat-config `linterOptions` object. A nil field is
+// unset and keeps the value merged from earlier entries.
+type LinterOptions struct {
+	NoInlineConfig *bool `json:"noInlineConfig,omitempty"`
+	// ReportUnusedDisableDirectives is a severity ("off", "warn", "error",
+	// 0, 1, 2) or a boolean, where true means "warn".
+	ReportUnusedDisableDirectives any `json:"reportUnusedDisableDirectives,omitempty"`
+	// ReportUnusedInlineConfigs is a severity.
+	ReportUnusedInlineConfigs any `json:"reportUnusedInlineConfigs,omitempty"`
+}
+
+func (lo *LinterOptions) validate() error {
+	if lo == nil {
+		return nil
+	}
+	if lo.ReportUnusedDisableDirectives != nil {
+		if _, ok := parseLinterOptionSeverity(lo.ReportUnusedDisableDirectives, true); !ok {
+			return fmt.Errorf("key \"linterOptions\": key \"reportUnusedDisableDirectives\": expected a severity or a boolean, got %v", lo.ReportUnusedDisableDirectives)
+		}
+	}
+	if lo.ReportUnusedInlineConfigs != nil {
+		if _, ok := parseLinterOptionSeverity(lo.ReportUnusedInlineConfigs, false); !ok {
+			return fmt.Errorf("key \"linterOptions\": key \"reportUnusedInlineConfigs\": expected a severity, got %v", lo.ReportUnusedInlineConfigs)
+		}
+	}
+	return nil
+}
+
+// parseLinterOptionSeverity reads a linterOptions severity. Booleans are
+// accepted only where ESLint accepts them, as "warn" and "off".
+func parseLinterOptionSeverity(value any, allowBool bool) (rule.DiagnosticSeverity, bool) {
+	switch v := value.(type) {
+	case bool:
+		if !allowBool {
+			return rule.SeverityOff, false
+		}
+		if v {
+			return rule.SeverityWarning, true
+		}
+		return rule.SeverityOff, true
+	case string:
+		return rule.ParseSeverityLevel(v)
+	case float64:
+		if v != float64(int(v)) {
+			return rule.SeverityOff, false
+		}
+		return rule.ParseSeverityLevel(fmt.Sprint(int(v)))
+	case int:
+		return rule.ParseSeverityLevel(fmt.Sprint(v))
+	default:
+		return rule.SeverityOff, false
+	}
+}
+
+// mergeLinterOptions applies the fields set in override on top of base.
+func mergeLinterOptions(base LinterOptions, override *LinterOptions) LinterOptions {
+	if override == nil {
+		return base
+	}
+	if override.NoInlineConfig != nil {
+		base.NoInlineConfig = override.NoInlineConfig
+	}
+	if override.ReportUnusedDisableDirectives != nil {
+		base.ReportUnusedDisableDirectives = override.ReportUnusedDisableDirectives
+	}
+	if override.ReportUnusedInlineConfigs != nil {
+		base.ReportUnusedInlineConfigs = override.ReportUnusedInlineConfigs
+	}
+	return base
+}
+
+// ExtractLinterOptions resolves the merged linterOptions for native execution.
+// Unset and invalid values leave inline config enabled and unreported.
+func ExtractLinterOptions(linterOpts LinterOptions) rule.LinterOptions {
+	var result rule.LinterOptions
+	if linterOpts.NoInlineConfig != nil {
+		result.NoInlineConfig = *linterOpts.NoInlineConfig
+	}
+	if severity, ok := parseLinterOptionSeverity(linterOpts.ReportUnusedDisableDirectives, true); ok && severity != rule.SeverityOff {
+		result.ReportUnusedDisableDirectives = true
+		result.UnusedDisableDirectiveSeverity = severity
+	}
+	if severity, ok := parseLinterOptionSeverity(linterOpts.ReportUnusedInlineConfigs, false); ok && severity != rule.SeverityOff {
+		result.ReportUnusedInlineConfigs = true
+		result.UnusedInlineConfigSeverity = severity
+	}
+	return result
+}
+
 // LanguageOptions contains language-specific configuration options.
 type LanguageOptions struct {
 	ParserOptions *ParserOptions `json:"parserOptions,omitempty"`
@@ -930,6 +1023,7 @@ type MergedConfig struct {
 	Rules           map[string]*RuleConfig
 	Settings        Settings
 	LanguageOptions *LanguageOptions
+	LinterOptions   LinterOptions
 	Plugins         map[string]struct{}
 }
 
@@ -1029,6 +1123,7 @@ func isGlobalIgnoreEntry(entry ConfigEntry) bool {
 		entry.Plugins == nil &&
 		entry.Settings == nil &&
 		entry.LanguageOptions == nil &&
+		entry.LinterOptions == nil &&
 		len(entry.Ignores) > 0
 }
 
@@ -6,6 +6,8 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+
+	"github.com/web-infra-dev/rslint/internal/rule"
 )
 
 func TestGetConfigForFile_ExplicitRulesOnly(t *testing.T) {
@@ -1051,3 +1053,121 @@ func TestGetConfigForFile_FilesAndGroupsUseOrOutsideAndInside(t *testing.T) {
 		}
 	}
 }
+
+func TestGetConfigForFile_LinterOptionsMerge(t *testing.T) {
+	var config RslintConfig
+	if err := json.Unmarshal([]byte(`[
+		{"linterOptions": {"noInlineConfig": true, "reportUnusedDisableDirectives": "error"}},
+		{"files": ["**/*.test.ts"], "linterOptions": {"noInlineConfig": false}},
+		{"files": ["legacy/**"], "linterOptions": {"reportUnusedDisableDirectives": false}}
+	]`), &config); err != nil {
+		t.Fatalf("unexpected decode error: %v", err)
+	}
+
+	tests := []struct {
+		file string
+		want rule.LinterOptions
+	}{
+		{
+			file: "src/app.ts",
+			want: rule.LinterOptions{NoInlineConfig: true, ReportUnusedDisableDirectives: true, UnusedDisableDirectiveSeverity: rule.SeverityError},
+		},
+		{
+			file: "src/app.test.ts",
+			want: rule.LinterOptions{ReportUnusedDisableDirectives: true, UnusedDisableDirectiveSeverity: rule.SeverityError},
+		},
+		{
+			file: "legacy/app.ts",
+			want: rule.LinterOptions{NoInlineConfig: true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			merged := config.GetConfigForFile(tt.file, "")
+			if merged == nil {
+				t.Fatal("Expected non-nil merged config")
+			}
+			if got := ExtractLinterOptions(merged.LinterOptions); got != tt.want {
+				t.Errorf("ExtractLinterOptions() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFileConfigResolver_EnvironmentWithoutEnabledRules(t *testing.T) {
+	var config RslintConfig
+	if err := json.Unmarshal([]byte(`[
+		{"linterOptions": {"noInlineConfig": true}},
+		{"files": ["off/**"], "rules": {"no-console": "off"}}
+	]`), &config); err != nil {
+		t.Fatalf("unexpected decode error: %v", err)
+	}
+	resolver := NewFileConfigResolver(config, "/repo", false)
+
+	resolved := resolver.ResolveTarget(DiscoveredLintTarget{Path: "/repo/off/app.ts"})
+	if len(resolved.EnabledRules) != 0 {
+		t.Fatalf("expected no enabled rules, got %+v", resolved.EnabledRules)
+	}
+	if resolved.Environment == nil || !resolved.Environment.LinterOptions.NoInlineConfig {
+		t.Fatalf("expected the merged linterOptions without enabled rules, got %+v", resolved.Environment)
+	}
+	if environment := resolver.EnvironmentForFile("/repo/off/app.ts"); environment != resolved.Environment {
+		t.Errorf("EnvironmentForFile() = %p, want the resolved environment %p", environment, resolved.Environment)
+	}
+}
+
+func TestExtractLinterOptions(t *testing.T) {
+	tests := []struct {
+		name string
+		opts LinterOptions
+		want rule.LinterOptions
+	}{
+		{name: "unset", opts: LinterOptions{}, want: rule.LinterOptions{}},
+		{
+			name: "true reports as warning",
+			opts: LinterOptions{ReportUnusedDisableDirectives: true},
+			want: rule.LinterOptions{ReportUnusedDisableDirectives: true, UnusedDisableDirectiveSeverity: rule.SeverityWarning},
+		},
+		{name: "false disables", opts: LinterOptions{ReportUnusedDisableDirectives: false}, want: rule.LinterOptions{}},
+		{name: "off disables", opts: LinterOptions{ReportUnusedDisableDirectives: "off"}, want: rule.LinterOptions{}},
+		{
+			name: "numeric severity",
+			opts: LinterOptions{ReportUnusedDisableDirectives: float64(2)},
+			want: rule.LinterOptions{ReportUnusedDisableDirectives: true, UnusedDisableDirectiveSeverity: rule.SeverityError},
+		},
+		{
+			name: "inline configs",
+			opts: LinterOptions{ReportUnusedInlineConfigs: "warn"},
+			want: rule.LinterOptions{ReportUnusedInlineConfigs: true, UnusedInlineConfigSeverity: rule.SeverityWarning},
+		},
+		{name: "inline configs reject booleans", opts: LinterOptions{ReportUnusedInlineConfigs: true}, want: rule.LinterOptions{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ExtractLinterOptions(tt.opts); got != tt.want {
+				t.Errorf("ExtractLinterOptions() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConfigDecode_RejectsInvalidLinterOptions(t *testing.T) {
+	for _, input := range []string{
+		`[{"linterOptions": {"reportUnusedDisableDirectives": "sometimes"}}]`,
+		`[{"linterOptions": {"reportUnusedDisableDirectives": 1.5}}]`,
+		`[{"linterOptions": {"reportUnusedInlineConfigs": true}}]`,
+	} {
+		var config RslintConfig
+		err := json.Unmarshal([]byte(input), &config)
+		if err == nil || !strings.Contains(err.Error(), `key "linterOptions"`) {
+			t.Errorf("json.Unmarshal(%s) error = %v, want linterOptions error", input, err)
+		}
+	}
+}
+
+func TestIsGlobalIgnoreEntry_LinterOptions(t *testing.T) {
+	entry := ConfigEntry{Ignores: []string{"dist/**"}, LinterOptions: &LinterOptions{NoInlineConfig: BoolPtr(true)}}
+	if isGlobalIgnoreEntry(entry) {
+		t.Error("Expected an entry with linterOptions to keep entry-local ignores")
+	}
+}
@@ -136,6 +136,7 @@ func (config RslintConfig) mergeConfigEntries(key configMatchKey) *MergedConfig
 		}
 
 		merged.LanguageOptions = mergeLanguageOptions(merged.LanguageOptions, entry.LanguageOptions)
+		merged.LinterOptions = mergeLinterOptions(merged.LinterOptions, entry.LinterOptions)
 	}
 
 	return merged
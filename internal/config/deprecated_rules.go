@@ -0,0 +1,43 @@
+package config
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// DeprecatedRuleWarnings returns one warning for every deprecated rule that
+// any entry of configs enables, sorted by rule name. Rules the registry does
+// not know are left to config validation.
+func DeprecatedRuleWarnings(registry *RuleRegistry, configs ...RslintConfig) []string {
+	enabled := make(map[string]struct{})
+	for _, config := range configs {
+		for _, entry := range config {
+			for ruleName, ruleValue := range entry.Rules {
+				ruleConfig, _, err := parseRuleConfigValue(ruleValue)
+				if err == nil && ruleConfig.IsEnabled() {
+					enabled[ruleName] = struct{}{}
+				}
+			}
+		}
+	}
+
+	var warnings []string
+	for ruleName := range enabled {
+		ruleImpl, exists := registry.GetRule(ruleName)
+		if !exists || !ruleImpl.Meta.Deprecated {
+			continue
+		}
+		warning := fmt.Sprintf("rule %q is deprecated", ruleName)
+		if len(ruleImpl.Meta.ReplacedBy) > 0 {
+			replacements := make([]string, len(ruleImpl.Meta.ReplacedBy))
+			for i, replacement := range ruleImpl.Meta.ReplacedBy {
+				replacements[i] = fmt.Sprintf("%q", replacement)
+			}
+			warning += "; use " + strings.Join(replacements, " or ") + " instead"
+		}
+		warnings = append(warnings, warning)
+	}
+	slices.Sort(warnings)
+	return warnings
+}
@@ -0,0 +1,34 @@
+package config
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/web-infra-dev/rslint/internal/rule"
+)
+
+func TestDeprecatedRuleWarnings(t *testing.T) {
+	registry := NewRuleRegistry()
+	registry.Register("old", rule.Rule{Name: "old", Meta: rule.RuleMeta{Deprecated: true, ReplacedBy: []string{"new"}}})
+	registry.Register("retired", rule.Rule{Name: "retired", Meta: rule.RuleMeta{Deprecated: true}})
+	registry.Register("new", rule.Rule{Name: "new"})
+
+	warnings := DeprecatedRuleWarnings(registry,
+		RslintConfig{
+			{Rules: Rules{"old": "warn", "new": "error"}},
+			{Files: []string{"*.js"}, Rules: Rules{"retired": []any{"error"}, "unknown": "error"}},
+		},
+		RslintConfig{{Rules: Rules{"old": "error", "retired": "off"}}},
+	)
+	want := []string{
+		`rule "old" is deprecated; use "new" instead`,
+		`rule "retired" is deprecated`,
+	}
+	if !reflect.DeepEqual(warnings, want) {
+		t.Errorf("warnings = %q, want %q", warnings, want)
+	}
+
+	if warnings := DeprecatedRuleWarnings(registry, RslintConfig{{Rules: Rules{"old": "off"}}}); len(warnings) != 0 {
+		t.Errorf("a disabled deprecated rule was reported: %q", warnings)
+	}
+}
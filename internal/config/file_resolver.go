@@ -9,15 +9,18 @@ import (
 type effectiveConfigPlan struct {
 	mergedConfig *MergedConfig
 	enabledRules []rule.ConfiguredRule
+	environment  *rule.RuleEnvironment
 }
 
 // ResolvedFileConfig is the immutable result of evaluating one frozen lint
 // target against a flat config. A nil MergedConfig means no config entry
 // selected the target; GloballyIgnored distinguishes the case where linting
 // must be skipped entirely from the case where syntax diagnostics still apply.
+// Environment is the one EnabledRules share, set even when no rule is enabled.
 type ResolvedFileConfig struct {
 	MergedConfig    *MergedConfig
 	EnabledRules    []rule.ConfiguredRule
+	Environment     *rule.RuleEnvironment
 	GloballyIgnored bool
 }
 
@@ -98,6 +101,18 @@ func (r *FileConfigResolver) EnabledRulesForTarget(filePath string, canonicalPat
 	return plan.enabledRules, plan.mergedConfig
 }
 
+// EnvironmentForFile returns the cached rule environment of filePath's merged
+// config, or nil when no config entry matches. Unlike the environment reached
+// through enabled rules, it exists for files whose config enables no rule.
+// The returned value is shared immutable resolver state and must be read-only.
+func (r *FileConfigResolver) EnvironmentForFile(filePath string) *rule.RuleEnvironment {
+	plan := r.planForFile(filePath)
+	if plan == nil {
+		return nil
+	}
+	return plan.environment
+}
+
 // ResolveTarget evaluates a complete target identity once. Callers that pass
 // a CanonicalParentPath avoid every later filesystem lookup needed to
 // distinguish a leaf symlink from an aliased directory tree.
@@ -112,6 +127,7 @@ func (r *FileConfigResolver) ResolveTarget(
 	if resolution.plan != nil {
 		result.MergedConfig = resolution.plan.mergedConfig
 		result.EnabledRules = resolution.plan.enabledRules
+		result.Environment = resolution.plan.environment
 	}
 	return result
 }
@@ -154,9 +170,11 @@ func (r *FileConfigResolver) resolutionForTarget(
 
 		resolution.plan = r.shapePlans.getOrInit(decision.key, func() *effectiveConfigPlan {
 			mergedConfig := r.config.mergeConfigEntries(decision.key)
+			enabledRules, environment := GlobalRuleRegistry.ResolveMergedConfig(mergedConfig, r.enforcePlugins)
 			return &effectiveConfigPlan{
 				mergedConfig: mergedConfig,
-				enabledRules: GlobalRuleRegistry.GetEnabledRulesForMergedConfig(mergedConfig, r.enforcePlugins),
+				enabledRules: enabledRules,
+				environment:  environment,
 			}
 		})
 		return resolution
@@ -0,0 +1,64 @@
+package config
+
+import (
+	"reflect"
+
+	"github.com/web-infra-dev/rslint/internal/rule"
+)
+
+// inlineRuleResolver resolves `/* eslint name: value */` entries against
+// mergedConfig the same way its own rule entries are resolved: the plugin
+// gate, severity parsing, and schema validation that fills option defaults.
+//
+// A severity-only entry keeps the options the config gives the rule, as in
+// ESLint. Options are cloned before validation because [rule.Schema.Validate]
+// fills defaults in place and mergedConfig is shared by every file of its
+// config shape.
+func (r *RuleRegistry) inlineRuleResolver(mergedConfig *MergedConfig, enforcePlugins bool, environment *rule.RuleEnvironment) rule.InlineRuleResolver {
+	return func(ruleName string, value any) (rule.InlineRuleResolution, error) {
+		ruleImpl, exists := r.ruleForMergedConfig(ruleName, mergedConfig, enforcePlugins)
+		if !exists {
+			return rule.InlineRuleResolution{}, rule.ErrUnknownInlineRule
+		}
+		ruleConfig, hasOptions, err := parseRuleConfigValue(value)
+		if err != nil {
+			return rule.InlineRuleResolution{}, err
+		}
+
+		configured := mergedConfig.Rules[ruleName]
+		if !hasOptions && configured != nil && len(configured.Options) > 0 {
+			ruleConfig.Options, _ = cloneConfigValue(configured.Options).([]any)
+		}
+		if ruleConfig.IsEnabled() && ruleImpl.Schema != nil {
+			if err := ruleImpl.Schema.Validate(ruleConfig.Options); err != nil {
+				return rule.InlineRuleResolution{}, err
+			}
+		}
+
+		resolution := rule.InlineRuleResolution{
+			Enabled:   ruleConfig.IsEnabled(),
+			Unchanged: inlineRuleUnchanged(configured, ruleConfig, hasOptions),
+		}
+		if resolution.Enabled {
+			resolution.Rule = newConfiguredRule(ruleName, ruleImpl, ruleConfig.GetSeverity(), ruleConfig.Options, environment)
+		}
+		return resolution, nil
+	}
+}
+
+// inlineRuleUnchanged reports whether an inline entry repeats the configured
+// severity and, when it gives options, the configured options. A rule the
+// config does not mention is off.
+func inlineRuleUnchanged(configured *RuleConfig, inline *RuleConfig, hasOptions bool) bool {
+	configuredLevel := "off"
+	if configured != nil {
+		configuredLevel = configured.GetLevel()
+	}
+	if inline.GetLevel() != configuredLevel {
+		return false
+	}
+	if !hasOptions {
+		return true
+	}
+	return configured != nil && reflect.DeepEqual(inline.Options, configured.Options)
+}
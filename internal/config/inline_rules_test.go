@@ -0,0 +1,97 @@
+package config
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/web-infra-dev/rslint/internal/rule"
+)
+
+func TestInlineRuleResolver(t *testing.T) {
+	registry := newOptionsTestRegistry()
+	registry.Register("plugin/rule", rule.Rule{Name: "plugin/rule", Schema: rule.EmptyArraySchema})
+
+	mergedConfig := &MergedConfig{
+		Rules: map[string]*RuleConfig{
+			"with-schema": {Level: "warn", Options: []any{map[string]any{"allow": []any{"log"}}}},
+			"no-options":  {Level: "error"},
+		},
+	}
+	rules := registry.GetEnabledRulesForMergedConfig(mergedConfig, true)
+	if len(rules) != 2 || rules[0].Environment.InlineRules == nil {
+		t.Fatalf("expected two rules sharing an inline rule resolver, got %+v", rules)
+	}
+	resolve := rules[0].Environment.InlineRules
+
+	t.Run("severity only keeps configured options", func(t *testing.T) {
+		resolution, err := resolve("with-schema", "error")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !resolution.Enabled || resolution.Unchanged || resolution.Rule.Severity != rule.SeverityError {
+			t.Fatalf("resolution = %+v, want an enabled error-level rule", resolution)
+		}
+		if want := []any{map[string]any{"allow": []any{"log"}}}; !reflect.DeepEqual(resolution.Rule.Options, want) {
+			t.Errorf("options = %#v, want %#v", resolution.Rule.Options, want)
+		}
+		if resolution.Rule.Environment != rules[0].Environment {
+			t.Error("expected the inline rule to share the file's environment")
+		}
+	})
+
+	t.Run("options replace configured options", func(t *testing.T) {
+		resolution, err := resolve("with-schema", []any{"warn", map[string]any{"allow": []any{"warn"}}})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if want := []any{map[string]any{"allow": []any{"warn"}}}; !reflect.DeepEqual(resolution.Rule.Options, want) {
+			t.Errorf("options = %#v, want %#v", resolution.Rule.Options, want)
+		}
+	})
+
+	t.Run("invalid options", func(t *testing.T) {
+		if _, err := resolve("with-schema", []any{"error", map[string]any{"deny": true}}); err == nil {
+			t.Error("expected a schema validation error")
+		}
+		if _, err := resolve("no-options", "sometimes"); err == nil {
+			t.Error("expected a severity error")
+		}
+	})
+
+	t.Run("unknown rules", func(t *testing.T) {
+		for _, name := range []string{"missing", "plugin/rule"} {
+			if _, err := resolve(name, "error"); !errors.Is(err, rule.ErrUnknownInlineRule) {
+				t.Errorf("resolve(%q) error = %v, want ErrUnknownInlineRule", name, err)
+			}
+		}
+	})
+
+	t.Run("unchanged entries", func(t *testing.T) {
+		tests := []struct {
+			name      string
+			value     any
+			unchanged bool
+		}{
+			{"with-schema", "warn", true},
+			{"with-schema", float64(1), true},
+			{"with-schema", []any{"warn", map[string]any{"allow": []any{"log"}}}, true},
+			{"with-schema", []any{"warn", map[string]any{"allow": []any{"info"}}}, false},
+			{"unmigrated", "off", true},
+			{"no-options", "off", false},
+		}
+		for _, tt := range tests {
+			resolution, err := resolve(tt.name, tt.value)
+			if err != nil {
+				t.Fatalf("resolve(%q, %v) error: %v", tt.name, tt.value, err)
+			}
+			if resolution.Unchanged != tt.unchanged {
+				t.Errorf("resolve(%q, %v).Unchanged = %v, want %v", tt.name, tt.value, resolution.Unchanged, tt.unchanged)
+			}
+		}
+	})
+
+	if want := []any{map[string]any{"allow": []any{"log"}}}; !reflect.DeepEqual(mergedConfig.Rules["with-schema"].Options, want) {
+		t.Errorf("configured options were modified: %#v", mergedConfig.Rules["with-schema"].Options)
+	}
+}
@@ -19,8 +19,12 @@ func NewRuleRegistry() *RuleRegistry {
 	}
 }
 
-// Register adds a rule to the registry
+// Register adds a rule to the registry. A native rule that declares no docs
+// URL is given its rslint.rs page.
 func (r *RuleRegistry) Register(ruleName string, ruleImpl rule.Rule) {
+	if !ruleImpl.IsEslintPluginRule && ruleImpl.Meta.DocsURL == "" {
+		ruleImpl.Meta.DocsURL = rule.DocsURL(ruleName)
+	}
 	r.rules[ruleName] = ruleImpl
 }
 
@@ -56,46 +60,31 @@ func (r *RuleRegistry) GetEnabledRules(config RslintConfig, filePath string, cwd
 // GetEnabledRulesForMergedConfig converts an already-resolved config into
 // enabled rule handlers without re-running files/ignores matching.
 func (r *RuleRegistry) GetEnabledRulesForMergedConfig(mergedConfig *MergedConfig, enforcePlugins bool) []rule.ConfiguredRule {
+	enabledRules, _ := r.ResolveMergedConfig(mergedConfig, enforcePlugins)
+	return enabledRules
+}
+
+// ResolveMergedConfig is GetEnabledRulesForMergedConfig that also returns the
+// rule environment the enabled rules share. The environment is built even
+// when no rule is enabled, because linterOptions still govern the file's
+// directive comments and inline rule comments may enable rules of their own.
+func (r *RuleRegistry) ResolveMergedConfig(mergedConfig *MergedConfig, enforcePlugins bool) ([]rule.ConfiguredRule, *rule.RuleEnvironment) {
 	if mergedConfig == nil {
-		return nil
+		return nil, nil
 	}
 
-	var environment *rule.RuleEnvironment
+	environment := &rule.RuleEnvironment{
+		Settings:        CloneSettings(mergedConfig.Settings),
+		LanguageOptions: ExtractLanguageOptions(mergedConfig.LanguageOptions),
+		Globals:         ExtractGlobals(mergedConfig.LanguageOptions),
+		LinterOptions:   ExtractLinterOptions(mergedConfig.LinterOptions),
+	}
+	environment.InlineRules = r.inlineRuleResolver(mergedConfig, enforcePlugins, environment)
 	var enabledRules []rule.ConfiguredRule
 	for ruleName, ruleConfig := range mergedConfig.Rules {
 		if ruleConfig.IsEnabled() {
-			// Plugin gate: when enforcePlugins is true, skip plugin rules
-			// whose plugin is not declared in the merged plugins set.
-			if enforcePlugins {
-				prefix := RulePluginPrefix(ruleName)
-				if prefix != "" {
-					if _, declared := mergedConfig.Plugins[prefix]; !declared {
-						continue
-					}
-				}
-			}
-
-			if ruleImpl, exists := r.rules[ruleName]; exists {
-				if environment == nil {
-					environment = &rule.RuleEnvironment{
-						Settings:        CloneSettings(mergedConfig.Settings),
-						LanguageOptions: ExtractLanguageOptions(mergedConfig.LanguageOptions),
-						Globals:         ExtractGlobals(mergedConfig.LanguageOptions),
-					}
-				}
-				ruleConfigCopy := ruleConfig
-				options := rule.NormalizeOptions(ruleConfigCopy.Options)
-				enabledRules = append(enabledRules, rule.ConfiguredRule{
-					Name:               ruleName,
-					Environment:        environment,
-					Severity:           ruleConfig.GetSeverity(),
-					RequiresTypeInfo:   ruleImpl.RequiresTypeInfo,
-					IsEslintPluginRule: ruleImpl.IsEslintPluginRule,
-					Options:            options,
-					Run: func(ctx rule.RuleContext) rule.RuleListeners {
-						return ruleImpl.Run(ctx, options)
-					},
-				})
+			if ruleImpl, exists := r.ruleForMergedConfig(ruleName, mergedConfig, enforcePlugins); exists {
+				enabledRules = append(enabledRules, newConfiguredRule(ruleName, ruleImpl, ruleConfig.GetSeverity(), ruleConfig.Options, environment))
 			}
 		}
 	}
@@ -108,7 +97,37 @@ func (r *RuleRegistry) GetEnabledRulesForMergedConfig(mergedConfig *MergedConfig
 		return strings.Compare(a.Name, b.Name)
 	})
 
-	return enabledRules
+	return enabledRules, environment
+}
+
+// ruleForMergedConfig looks up a rule enabled by mergedConfig. When
+// enforcePlugins is true, plugin rules whose plugin is not declared in the
+// merged plugins set are treated as unknown.
+func (r *RuleRegistry) ruleForMergedConfig(ruleName string, mergedConfig *MergedConfig, enforcePlugins bool) (rule.Rule, bool) {
+	if enforcePlugins {
+		if prefix := RulePluginPrefix(ruleName); prefix != "" {
+			if _, declared := mergedConfig.Plugins[prefix]; !declared {
+				return rule.Rule{}, false
+			}
+		}
+	}
+	ruleImpl, exists := r.rules[ruleName]
+	return ruleImpl, exists
+}
+
+func newConfiguredRule(ruleName string, ruleImpl rule.Rule, severity rule.DiagnosticSeverity, rawOptions []any, environment *rule.RuleEnvironment) rule.ConfiguredRule {
+	options := rule.NormalizeOptions(rawOptions)
+	return rule.ConfiguredRule{
+		Name:               ruleName,
+		Environment:        environment,
+		Severity:           severity,
+		RequiresTypeInfo:   ruleImpl.RequiresTypeInfo,
+		IsEslintPluginRule: ruleImpl.IsEslintPluginRule,
+		Options:            options,
+		Run: func(ctx rule.RuleContext) rule.RuleListeners {
+			return ruleImpl.Run(ctx, options)
+		},
+	}
 }
 
 func CloneSettings(settings map[string]interface{}) map[string]interface{} {
@@ -0,0 +1,550 @@
+// Package lintcache persists CLI lint results between runs (`--cache`).
+//
+// One cache file holds two tables. The file table records, for every source
+// file a cached result depends on, its size, modification time, xxh3 content
+// hash, and the files it references through the Program's ModuleGraph. The
+// result table records, for every lint target, the fingerprint of the
+// effective configuration it was linted under, the rules that ran, and the
+// diagnostics that run produced. Rule lists are stored once per distinct set
+// and referenced by hash, since most targets share a handful of them.
+//
+// A result replays only when its fingerprint matches and neither the file nor
+// anything it transitively references has changed since it was recorded —
+// type-aware rules read other files through the checker, so a change anywhere
+// in the import closure can change their answer. The cache as a whole is
+// discarded when it was written by a different rslint build.
+//
+// A Cache is owned by one CLI run and is not safe for concurrent use.
+package lintcache
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/core"
+	"github.com/microsoft/typescript-go/shim/vfs"
+	"github.com/web-infra-dev/rslint/internal/linter"
+	"github.com/web-infra-dev/rslint/internal/rule"
+	"github.com/zeebo/xxh3"
+)
+
+// DefaultFileName is the cache file written to the working directory, or
+// into a directory given as --cache-location.
+const DefaultFileName = ".rslintcache"
+
+// formatVersion changes whenever the on-disk layout does. A cache in any
+// other layout is discarded rather than migrated.
+const formatVersion = 2
+
+// Strategy selects how a file is judged unchanged.
+type Strategy uint8
+
+const (
+	// StrategyMetadata trusts an unchanged size and modification time and
+	// falls back to the content hash only when they differ, so touching a file
+	// costs one read rather than a re-lint.
+	StrategyMetadata Strategy = iota
+	// StrategyContent always compares content hashes. It suits checkouts whose
+	// modification times are not meaningful, such as fresh CI clones.
+	StrategyContent
+)
+
+// ParseStrategy maps a --cache-strategy value to a Strategy.
+func ParseStrategy(value string) (Strategy, error) {
+	switch value {
+	case "", "metadata":
+		return StrategyMetadata, nil
+	case "content":
+		return StrategyContent, nil
+	default:
+		return StrategyMetadata, fmt.Errorf("invalid cache strategy %q: expected metadata or content", value)
+	}
+}
+
+func (s Strategy) String() string {
+	if s == StrategyContent {
+		return "content"
+	}
+	return "metadata"
+}
+
+// ResolveLocation returns the cache file for a --cache-location value. An
+// empty location selects DefaultFileName in cwd; a location naming an
+// existing directory, or ending in a path separator, holds DefaultFileName.
+func ResolveLocation(location string, cwd string) string {
+	if location == "" {
+		return filepath.Join(cwd, DefaultFileName)
+	}
+	isDirectory := strings.HasSuffix(location, "/") || strings.HasSuffix(location, string(filepath.Separator))
+	if !filepath.IsAbs(location) {
+		location = filepath.Join(cwd, location)
+	}
+	if !isDirectory {
+		if info, err := os.Stat(location); err == nil && info.IsDir() {
+			isDirectory = true
+		}
+	}
+	if isDirectory {
+		return filepath.Join(location, DefaultFileName)
+	}
+	return location
+}
+
+// Fingerprint hashes any JSON-encodable value into a stable key. Callers use
+// it to reduce a file's effective configuration and rule set to the string
+// stored with its result.
+func Fingerprint(value any) (string, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return hashString(string(data)), nil
+}
+
+func hashString(text string) string {
+	sum := xxh3.HashString128(text).Bytes()
+	return fmt.Sprintf("%x", sum[:])
+}
+
+type fileState struct {
+	Size         int64    `json:"size"`
+	ModTime      int64    `json:"mtime"`
+	Hash         string   `json:"hash"`
+	Dependencies []string `json:"dependencies,omitempty"`
+}
+
+type result struct {
+	Config string `json:"config"`
+	// Fixed marks a result recorded by a --fix run: its remaining diagnostics
+	// carry no fix that run could apply, so a later --fix run may replay it.
+	Fixed bool `json:"fixed,omitempty"`
+	// Rules keys the executed rule list in cacheFile.RuleSets.
+	Rules       string       `json:"rules"`
+	Diagnostics []diagnostic `json:"diagnostics,omitempty"`
+}
+
+type diagnostic struct {
+	RuleName     string            `json:"ruleName"`
+	MessageId    string            `json:"messageId,omitempty"`
+	Message      string            `json:"message"`
+	Data         map[string]string `json:"data,omitempty"`
+	Severity     string            `json:"severity"`
+	TypeScript   bool              `json:"typescript,omitempty"`
+	PreFormatted bool              `json:"preFormatted,omitempty"`
+	Pos          int               `json:"pos"`
+	End          int               `json:"end"`
+	Fixes        []fix             `json:"fixes,omitempty"`
+	Suggestions  []suggestion      `json:"suggestions,omitempty"`
+}
+
+type suggestion struct {
+	MessageId string            `json:"messageId,omitempty"`
+	Message   string            `json:"message"`
+	Data      map[string]string `json:"data,omitempty"`
+	Fixes     []fix             `json:"fixes,omitempty"`
+}
+
+type fix struct {
+	Text string `json:"text"`
+	Pos  int    `json:"pos"`
+	End  int    `json:"end"`
+}
+
+type cacheFile struct {
+	Format   int                  `json:"format"`
+	Version  string               `json:"version"`
+	Files    map[string]fileState `json:"files"`
+	RuleSets map[string][]string  `json:"ruleSets"`
+	Results  map[string]*result   `json:"results"`
+}
+
+// Cache is one run's view of a cache file: the state loaded at start plus the
+// results recorded during the run, merged on Save.
+type Cache struct {
+	version  string
+	strategy Strategy
+	fsys     vfs.FS
+
+	files    map[string]fileState
+	ruleSets map[string][]string
+	results  map[string]*result
+
+	// unchanged memoizes per-run verdicts on file-table entries; a file is
+	// checked against disk at most once however many results depend on it.
+	unchanged map[string]bool
+
+	recordedFiles   map[string]fileState
+	recordedResults map[string]*result
+}
+
+// Load reads the cache file at path. A missing, unreadable, or foreign cache
+// (another layout or rslint build) yields an empty cache: it is only ever an
+// optimization, so a bad file costs one full run and is then rewritten.
+// fsys reads source text exactly as the compiler host does, so hashes agree
+// with what the loader parsed.
+func Load(path string, version string, strategy Strategy, fsys vfs.FS) *Cache {
+	cache := &Cache{
+		version:         version,
+		strategy:        strategy,
+		fsys:            fsys,
+		files:           map[string]fileState{},
+		ruleSets:        map[string][]string{},
+		results:         map[string]*result{},
+		unchanged:       map[string]bool{},
+		recordedFiles:   map[string]fileState{},
+		recordedResults: map[string]*result{},
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return cache
+	}
+	var stored cacheFile
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return cache
+	}
+	if stored.Format != formatVersion || stored.Version != version {
+		return cache
+	}
+	if stored.Files != nil {
+		cache.files = stored.Files
+	}
+	if stored.RuleSets != nil {
+		cache.ruleSets = stored.RuleSets
+	}
+	for filePath, recorded := range stored.Results {
+		if recorded != nil {
+			cache.results[filePath] = recorded
+		}
+	}
+	return cache
+}
+
+// Hit is a replayable result.
+type Hit struct {
+	// Rules names every rule the recorded run executed on the file.
+	Rules []string
+	// Diagnostics anchor to a text-only source file; they carry no AST.
+	Diagnostics []rule.RuleDiagnostic
+}
+
+// Lookup returns the recorded result for filePath when config matches the
+// fingerprint it was recorded under and nothing in its dependency closure has
+// changed. requireFixed restricts hits to results recorded by a --fix run.
+func (c *Cache) Lookup(filePath string, config string, requireFixed bool) (Hit, bool) {
+	stored := c.results[filePath]
+	if stored == nil || stored.Config != config || (requireFixed && !stored.Fixed && len(stored.Diagnostics) > 0) {
+		return Hit{}, false
+	}
+	rules, ok := c.ruleSets[stored.Rules]
+	if !ok || !c.closureUnchanged(filePath, map[string]bool{}) {
+		return Hit{}, false
+	}
+	hit := Hit{Rules: slices.Clone(rules)}
+	if len(stored.Diagnostics) == 0 {
+		return hit, true
+	}
+	text, ok := c.fsys.ReadFile(filePath)
+	if !ok {
+		return Hit{}, false
+	}
+	sourceFile := linter.NewTextSourceFile(text)
+	hit.Diagnostics = make([]rule.RuleDiagnostic, 0, len(stored.Diagnostics))
+	for _, recorded := range stored.Diagnostics {
+		hit.Diagnostics = append(hit.Diagnostics, recorded.toRuleDiagnostic(filePath, sourceFile))
+	}
+	return hit, true
+}
+
+// closureUnchanged reports whether filePath and every file it transitively
+// references still match the file table. A reference cycle is judged by the
+// files outside it: visiting marks the path in progress before descending.
+func (c *Cache) closureUnchanged(filePath string, visiting map[string]bool) bool {
+	if visiting[filePath] {
+		return true
+	}
+	if !c.fileUnchanged(filePath) {
+		return false
+	}
+	visiting[filePath] = true
+	for _, dependency := range c.files[filePath].Dependencies {
+		if !c.closureUnchanged(dependency, visiting) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *Cache) fileUnchanged(filePath string) bool {
+	if verdict, ok := c.unchanged[filePath]; ok {
+		return verdict
+	}
+	verdict := c.checkFile(filePath)
+	c.unchanged[filePath] = verdict
+	return verdict
+}
+
+func (c *Cache) checkFile(filePath string) bool {
+	state, ok := c.files[filePath]
+	if !ok {
+		return false
+	}
+	info, err := os.Stat(filePath)
+	if err != nil {
+		return false
+	}
+	if c.strategy == StrategyMetadata && info.Size() == state.Size && info.ModTime().UnixNano() == state.ModTime {
+		return true
+	}
+	text, ok := c.fsys.ReadFile(filePath)
+	return ok && hashString(text) == state.Hash
+}
+
+// Record describes one linted target for RecordResult.
+type Record struct {
+	Path string
+	// Text is the source text the diagnostics were produced from.
+	Text   string
+	Config string
+	Fixed  bool
+	Rules  []string
+	// Diagnostics must all belong to Path.
+	Diagnostics []rule.RuleDiagnostic
+	// Dependencies are the files Path directly references. Each must also be
+	// passed to RecordFile so its own closure can be checked later.
+	Dependencies []string
+}
+
+// RecordResult stores a target's result, replacing any recorded earlier.
+func (c *Cache) RecordResult(record Record) {
+	rules := slices.Clone(record.Rules)
+	slices.Sort(rules)
+	rulesKey := hashString(strings.Join(rules, "\n"))
+	if _, ok := c.ruleSets[rulesKey]; !ok {
+		c.ruleSets[rulesKey] = rules
+	}
+	stored := &result{
+		Config: record.Config,
+		Fixed:  record.Fixed,
+		Rules:  rulesKey,
+	}
+	for _, d := range record.Diagnostics {
+		stored.Diagnostics = append(stored.Diagnostics, newDiagnostic(d))
+	}
+	c.recordedResults[record.Path] = stored
+	c.RecordFile(record.Path, record.Text, record.Dependencies)
+}
+
+// RecordFile stores the state of a file some recorded result depends on.
+// The first record of a path in a run wins: all of them describe the same
+// source generation.
+func (c *Cache) RecordFile(filePath string, text string, dependencies []string) {
+	if _, ok := c.recordedFiles[filePath]; ok {
+		return
+	}
+	state := fileState{Hash: hashString(text), Dependencies: slices.Clone(dependencies)}
+	if info, err := os.Stat(filePath); err == nil {
+		state.Size = info.Size()
+		state.ModTime = info.ModTime().UnixNano()
+	}
+	c.recordedFiles[filePath] = state
+}
+
+// Save merges this run's records into the loaded state and writes the cache
+// file. Results recorded before this run that depend on a file whose content
+// changed are dropped first: once the file table describes the new content,
+// their closure check could no longer tell they were stale. Files no longer
+// reachable from any result, and rule sets no result references, are pruned.
+func (c *Cache) Save(path string) error {
+	changed := make([]string, 0)
+	for filePath, recorded := range c.recordedFiles {
+		if previous, ok := c.files[filePath]; ok && previous.Hash != recorded.Hash {
+			changed = append(changed, filePath)
+		}
+	}
+	if len(changed) > 0 {
+		c.dropDependents(changed)
+	}
+	for filePath, recorded := range c.recordedFiles {
+		c.files[filePath] = recorded
+	}
+	for filePath, recorded := range c.recordedResults {
+		c.results[filePath] = recorded
+	}
+	c.prune()
+
+	data, err := json.Marshal(cacheFile{
+		Format:   formatVersion,
+		Version:  c.version,
+		Files:    c.files,
+		RuleSets: c.ruleSets,
+		Results:  c.results,
+	})
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	// Write beside the target and rename so a concurrent or interrupted run
+	// never observes a truncated cache.
+	temp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	_, writeErr := temp.Write(data)
+	closeErr := temp.Close()
+	if err := errors.Join(writeErr, closeErr); err != nil {
+		_ = os.Remove(temp.Name())
+		return err
+	}
+	if err := os.Rename(temp.Name(), path); err != nil {
+		_ = os.Remove(temp.Name())
+		return err
+	}
+	return nil
+}
+
+// dropDependents removes every result that was not recorded in this run and
+// whose file transitively references one of changed, following the file
+// table's edges in reverse.
+func (c *Cache) dropDependents(changed []string) {
+	dependents := make(map[string][]string)
+	for filePath, state := range c.files {
+		for _, dependency := range state.Dependencies {
+			dependents[dependency] = append(dependents[dependency], filePath)
+		}
+	}
+	seen := make(map[string]bool, len(changed))
+	queue := changed
+	for len(queue) > 0 {
+		filePath := queue[0]
+		queue = queue[1:]
+		if seen[filePath] {
+			continue
+		}
+		seen[filePath] = true
+		if _, recorded := c.recordedResults[filePath]; !recorded {
+			delete(c.results, filePath)
+		}
+		queue = append(queue, dependents[filePath]...)
+	}
+}
+
+func (c *Cache) prune() {
+	reachable := make(map[string]bool, len(c.files))
+	var visit func(filePath string)
+	visit = func(filePath string) {
+		if reachable[filePath] {
+			return
+		}
+		state, ok := c.files[filePath]
+		if !ok {
+			return
+		}
+		reachable[filePath] = true
+		for _, dependency := range state.Dependencies {
+			visit(dependency)
+		}
+	}
+	for filePath := range c.results {
+		visit(filePath)
+	}
+	for filePath := range c.files {
+		if !reachable[filePath] {
+			delete(c.files, filePath)
+		}
+	}
+
+	referenced := make(map[string]bool, len(c.ruleSets))
+	for _, stored := range c.results {
+		referenced[stored.Rules] = true
+	}
+	for key := range c.ruleSets {
+		if !referenced[key] {
+			delete(c.ruleSets, key)
+		}
+	}
+}
+
+func newDiagnostic(d rule.RuleDiagnostic) diagnostic {
+	recorded := diagnostic{
+		RuleName:     d.RuleName,
+		MessageId:    d.Message.Id,
+		Message:      d.Message.Description,
+		Data:         d.Message.Data,
+		Severity:     d.Severity.String(),
+		TypeScript:   d.Origin == rule.DiagnosticOriginTypeScript,
+		PreFormatted: d.PreFormatted,
+		Pos:          d.Range.Pos(),
+		End:          d.Range.End(),
+	}
+	recorded.Fixes = newFixes(d.Fixes())
+	if d.Suggestions == nil {
+		return recorded
+	}
+	for _, s := range *d.Suggestions {
+		recorded.Suggestions = append(recorded.Suggestions, suggestion{
+			MessageId: s.Message.Id,
+			Message:   s.Message.Description,
+			Data:      s.Message.Data,
+			Fixes:     newFixes(s.Fixes()),
+		})
+	}
+	return recorded
+}
+
+func newFixes(fixes []rule.RuleFix) []fix {
+	var recorded []fix
+	for _, f := range fixes {
+		recorded = append(recorded, fix{Text: f.Text, Pos: f.Range.Pos(), End: f.Range.End()})
+	}
+	return recorded
+}
+
+func toRuleFixes(recorded []fix) []rule.RuleFix {
+	fixes := make([]rule.RuleFix, 0, len(recorded))
+	for _, f := range recorded {
+		fixes = append(fixes, rule.RuleFix{Text: f.Text, Range: core.NewTextRange(f.Pos, f.End)})
+	}
+	return fixes
+}
+
+func (d diagnostic) toRuleDiagnostic(filePath string, sourceFile ast.SourceFileLike) rule.RuleDiagnostic {
+	replayed := rule.RuleDiagnostic{
+		Range:    core.NewTextRange(d.Pos, d.End),
+		RuleName: d.RuleName,
+		Message: rule.RuleMessage{
+			Id:          d.MessageId,
+			Description: d.Message,
+			Data:        d.Data,
+		},
+		SourceFile:   sourceFile,
+		FilePath:     filePath,
+		Severity:     rule.ParseSeverity(d.Severity),
+		PreFormatted: d.PreFormatted,
+	}
+	if d.TypeScript {
+		replayed.Origin = rule.DiagnosticOriginTypeScript
+	}
+	if len(d.Fixes) > 0 {
+		fixes := toRuleFixes(d.Fixes)
+		replayed.FixesPtr = &fixes
+	}
+	if len(d.Suggestions) > 0 {
+		suggestions := make([]rule.RuleSuggestion, 0, len(d.Suggestions))
+		for _, s := range d.Suggestions {
+			suggestions = append(suggestions, rule.RuleSuggestion{
+				Message:  rule.RuleMessage{Id: s.MessageId, Description: s.Message, Data: s.Data},
+				FixesArr: toRuleFixes(s.Fixes),
+			})
+		}
+		replayed.Suggestions = &suggestions
+	}
+	return replayed
+}
@@ -0,0 +1,377 @@
+package lintcache
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/microsoft/typescript-go/shim/core"
+	"github.com/microsoft/typescript-go/shim/vfs/osvfs"
+	"github.com/web-infra-dev/rslint/internal/rule"
+)
+
+const testVersion = "test-version"
+
+func TestParseStrategy(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    Strategy
+		wantErr bool
+	}{
+		{value: "", want: StrategyMetadata},
+		{value: "metadata", want: StrategyMetadata},
+		{value: "content", want: StrategyContent},
+		{value: "mtime", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := ParseStrategy(tt.value)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseStrategy(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			continue
+		}
+		if !tt.wantErr && got != tt.want {
+			t.Errorf("ParseStrategy(%q) = %v, want %v", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestResolveLocation(t *testing.T) {
+	cwd := t.TempDir()
+	dir := filepath.Join(cwd, "cache-dir")
+	if err := os.Mkdir(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		location string
+		want     string
+	}{
+		{location: "", want: filepath.Join(cwd, DefaultFileName)},
+		{location: "custom.json", want: filepath.Join(cwd, "custom.json")},
+		{location: "cache-dir", want: filepath.Join(dir, DefaultFileName)},
+		{location: "missing-dir/", want: filepath.Join(cwd, "missing-dir", DefaultFileName)},
+		{location: filepath.Join(dir, "abs.json"), want: filepath.Join(dir, "abs.json")},
+	}
+	for _, tt := range tests {
+		if got := ResolveLocation(tt.location, cwd); got != tt.want {
+			t.Errorf("ResolveLocation(%q) = %q, want %q", tt.location, got, tt.want)
+		}
+	}
+}
+
+type cacheFixture struct {
+	t         *testing.T
+	dir       string
+	cachePath string
+}
+
+func newCacheFixture(t *testing.T) *cacheFixture {
+	t.Helper()
+	dir := t.TempDir()
+	return &cacheFixture{t: t, dir: dir, cachePath: filepath.Join(dir, DefaultFileName)}
+}
+
+// write stores text at name and pins its modification time, so metadata
+// checks are independent of the file system's timestamp resolution.
+func (f *cacheFixture) write(name string, text string, modTime time.Time) string {
+	f.t.Helper()
+	filePath := filepath.Join(f.dir, name)
+	if err := os.WriteFile(filePath, []byte(text), 0o644); err != nil {
+		f.t.Fatal(err)
+	}
+	if err := os.Chtimes(filePath, modTime, modTime); err != nil {
+		f.t.Fatal(err)
+	}
+	return filePath
+}
+
+func (f *cacheFixture) load(strategy Strategy) *Cache {
+	return Load(f.cachePath, testVersion, strategy, osvfs.FS())
+}
+
+func (f *cacheFixture) save(cache *Cache) {
+	f.t.Helper()
+	if err := cache.Save(f.cachePath); err != nil {
+		f.t.Fatalf("Save() error = %v", err)
+	}
+}
+
+var baseTime = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func testDiagnostic(filePath string) rule.RuleDiagnostic {
+	fixes := []rule.RuleFix{{Text: "const", Range: core.NewTextRange(0, 3)}}
+	suggestions := []rule.RuleSuggestion{{
+		Message:  rule.RuleMessage{Id: "removeLet", Description: "Remove the declaration."},
+		FixesArr: []rule.RuleFix{{Text: "", Range: core.NewTextRange(0, 10)}},
+	}}
+	return rule.RuleDiagnostic{
+		Range:    core.NewTextRange(0, 3),
+		RuleName: "prefer-const",
+		Message: rule.RuleMessage{
+			Id:          "useConst",
+			Description: "'a' is never reassigned.",
+			Data:        map[string]string{"name": "a"},
+		},
+		FilePath:    filePath,
+		Severity:    rule.SeverityWarning,
+		FixesPtr:    &fixes,
+		Suggestions: &suggestions,
+	}
+}
+
+func TestCacheRoundTrip(t *testing.T) {
+	f := newCacheFixture(t)
+	text := "let a = 1;\n"
+	target := f.write("a.ts", text, baseTime)
+
+	cache := f.load(StrategyMetadata)
+	cache.RecordResult(Record{
+		Path:        target,
+		Text:        text,
+		Config:      "config-1",
+		Rules:       []string{"prefer-const", "no-var"},
+		Diagnostics: []rule.RuleDiagnostic{testDiagnostic(target)},
+	})
+	f.save(cache)
+
+	hit, ok := f.load(StrategyMetadata).Lookup(target, "config-1", false)
+	if !ok {
+		t.Fatal("Lookup() missed an unchanged file")
+	}
+	if len(hit.Rules) != 2 || hit.Rules[0] != "no-var" || hit.Rules[1] != "prefer-const" {
+		t.Errorf("hit.Rules = %v, want sorted [no-var prefer-const]", hit.Rules)
+	}
+	if len(hit.Diagnostics) != 1 {
+		t.Fatalf("len(hit.Diagnostics) = %d, want 1", len(hit.Diagnostics))
+	}
+	got := hit.Diagnostics[0]
+	if got.RuleName != "prefer-const" || got.Message.Id != "useConst" || got.Message.Data["name"] != "a" {
+		t.Errorf("replayed diagnostic = %+v", got)
+	}
+	if got.Severity != rule.SeverityWarning {
+		t.Errorf("Severity = %v, want warning", got.Severity)
+	}
+	if got.FilePath != target || got.Range.Pos() != 0 || got.Range.End() != 3 {
+		t.Errorf("location = %s %d-%d", got.FilePath, got.Range.Pos(), got.Range.End())
+	}
+	if got.SourceFile == nil || got.SourceFile.Text() != text {
+		t.Error("replayed diagnostic is not anchored to the file text")
+	}
+	if fixes := got.Fixes(); len(fixes) != 1 || fixes[0].Text != "const" {
+		t.Errorf("Fixes() = %+v", fixes)
+	}
+	if got.Suggestions == nil || len(*got.Suggestions) != 1 {
+		t.Fatalf("Suggestions = %+v, want one suggestion", got.Suggestions)
+	}
+	if s := (*got.Suggestions)[0]; s.Message.Id != "removeLet" || len(s.Fixes()) != 1 || s.Fixes()[0].Range.End() != 10 {
+		t.Errorf("replayed suggestion = %+v", s)
+	}
+}
+
+func TestCacheLookupMisses(t *testing.T) {
+	tests := []struct {
+		name   string
+		mutate func(f *cacheFixture, target string)
+		config string
+	}{
+		{
+			name:   "config changed",
+			mutate: func(*cacheFixture, string) {},
+			config: "config-2",
+		},
+		{
+			name: "content changed",
+			mutate: func(f *cacheFixture, _ string) {
+				f.write("a.ts", "var a = 1;\n", baseTime.Add(time.Hour))
+			},
+			config: "config-1",
+		},
+		{
+			name: "file removed",
+			mutate: func(_ *cacheFixture, target string) {
+				_ = os.Remove(target)
+			},
+			config: "config-1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newCacheFixture(t)
+			text := "let a = 1;\n"
+			target := f.write("a.ts", text, baseTime)
+			cache := f.load(StrategyMetadata)
+			cache.RecordResult(Record{Path: target, Text: text, Config: "config-1"})
+			f.save(cache)
+
+			tt.mutate(f, target)
+			if _, ok := f.load(StrategyMetadata).Lookup(target, tt.config, false); ok {
+				t.Error("Lookup() hit, want miss")
+			}
+		})
+	}
+}
+
+func TestCacheVersionMismatch(t *testing.T) {
+	f := newCacheFixture(t)
+	text := "let a = 1;\n"
+	target := f.write("a.ts", text, baseTime)
+	cache := f.load(StrategyMetadata)
+	cache.RecordResult(Record{Path: target, Text: text, Config: "config-1"})
+	f.save(cache)
+
+	other := Load(f.cachePath, "other-version", StrategyMetadata, osvfs.FS())
+	if _, ok := other.Lookup(target, "config-1", false); ok {
+		t.Error("Lookup() replayed a result written by another version")
+	}
+}
+
+func TestCacheTransitiveDependencyChange(t *testing.T) {
+	f := newCacheFixture(t)
+	target := f.write("a.ts", "import { b } from './b';\n", baseTime)
+	middle := f.write("b.ts", "export { c as b } from './c';\n", baseTime)
+	leaf := f.write("c.ts", "export const c = 1;\n", baseTime)
+
+	cache := f.load(StrategyMetadata)
+	cache.RecordResult(Record{
+		Path:         target,
+		Text:         "import { b } from './b';\n",
+		Config:       "config-1",
+		Dependencies: []string{middle},
+	})
+	cache.RecordFile(middle, "export { c as b } from './c';\n", []string{leaf})
+	cache.RecordFile(leaf, "export const c = 1;\n", nil)
+	f.save(cache)
+
+	if _, ok := f.load(StrategyMetadata).Lookup(target, "config-1", false); !ok {
+		t.Fatal("Lookup() missed with an unchanged closure")
+	}
+	f.write("c.ts", "export const c = 'one';\n", baseTime.Add(time.Hour))
+	if _, ok := f.load(StrategyMetadata).Lookup(target, "config-1", false); ok {
+		t.Error("Lookup() hit after a transitive dependency changed")
+	}
+}
+
+// A run that lints only the changed dependency rewrites its file-table entry.
+// The result of an importer recorded earlier must not survive that rewrite,
+// since its closure would then look unchanged.
+func TestCacheSaveDropsStaleDependents(t *testing.T) {
+	f := newCacheFixture(t)
+	importer := f.write("a.ts", "import { b } from './b';\n", baseTime)
+	dependency := f.write("b.ts", "export const b = 1;\n", baseTime)
+
+	cache := f.load(StrategyMetadata)
+	cache.RecordResult(Record{
+		Path:         importer,
+		Text:         "import { b } from './b';\n",
+		Config:       "config-1",
+		Dependencies: []string{dependency},
+	})
+	cache.RecordResult(Record{Path: dependency, Text: "export const b = 1;\n", Config: "config-1"})
+	f.save(cache)
+
+	changedText := "export const b = 'one';\n"
+	f.write("b.ts", changedText, baseTime.Add(time.Hour))
+	cache = f.load(StrategyMetadata)
+	cache.RecordResult(Record{Path: dependency, Text: changedText, Config: "config-1"})
+	f.save(cache)
+
+	reloaded := f.load(StrategyMetadata)
+	if _, ok := reloaded.Lookup(importer, "config-1", false); ok {
+		t.Error("Lookup() replayed an importer recorded against the old dependency")
+	}
+	if _, ok := reloaded.Lookup(dependency, "config-1", false); !ok {
+		t.Error("Lookup() missed the re-recorded dependency")
+	}
+}
+
+func TestCacheRequireFixed(t *testing.T) {
+	f := newCacheFixture(t)
+	dirtyText := "let a = 1;\n"
+	dirty := f.write("dirty.ts", dirtyText, baseTime)
+	clean := f.write("clean.ts", "const a = 1;\n", baseTime)
+	fixed := f.write("fixed.ts", dirtyText, baseTime)
+
+	cache := f.load(StrategyMetadata)
+	cache.RecordResult(Record{
+		Path:        dirty,
+		Text:        dirtyText,
+		Config:      "config-1",
+		Diagnostics: []rule.RuleDiagnostic{testDiagnostic(dirty)},
+	})
+	cache.RecordResult(Record{Path: clean, Text: "const a = 1;\n", Config: "config-1"})
+	cache.RecordResult(Record{
+		Path:        fixed,
+		Text:        dirtyText,
+		Config:      "config-1",
+		Fixed:       true,
+		Diagnostics: []rule.RuleDiagnostic{testDiagnostic(fixed)},
+	})
+	f.save(cache)
+
+	reloaded := f.load(StrategyMetadata)
+	tests := []struct {
+		path string
+		want bool
+	}{
+		{path: dirty, want: false},
+		{path: clean, want: true},
+		{path: fixed, want: true},
+	}
+	for _, tt := range tests {
+		if _, ok := reloaded.Lookup(tt.path, "config-1", true); ok != tt.want {
+			t.Errorf("Lookup(%s, requireFixed) = %v, want %v", filepath.Base(tt.path), ok, tt.want)
+		}
+	}
+}
+
+// Rewriting a file with identical bytes changes only its modification time;
+// either strategy replays it. Keeping the size and modification time while
+// changing bytes is visible only to the content strategy.
+func TestCacheStrategies(t *testing.T) {
+	text := "let a = 1;\n"
+	sameSize := "let b = 1;\n"
+	tests := []struct {
+		name     string
+		strategy Strategy
+		rewrite  string
+		modTime  time.Time
+		want     bool
+	}{
+		{name: "metadata touched", strategy: StrategyMetadata, rewrite: text, modTime: baseTime.Add(time.Hour), want: true},
+		{name: "content touched", strategy: StrategyContent, rewrite: text, modTime: baseTime.Add(time.Hour), want: true},
+		{name: "metadata same stat", strategy: StrategyMetadata, rewrite: sameSize, modTime: baseTime, want: true},
+		{name: "content same stat", strategy: StrategyContent, rewrite: sameSize, modTime: baseTime, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newCacheFixture(t)
+			target := f.write("a.ts", text, baseTime)
+			cache := f.load(tt.strategy)
+			cache.RecordResult(Record{Path: target, Text: text, Config: "config-1"})
+			f.save(cache)
+
+			f.write("a.ts", tt.rewrite, tt.modTime)
+			if _, ok := f.load(tt.strategy).Lookup(target, "config-1", false); ok != tt.want {
+				t.Errorf("Lookup() = %v, want %v", ok, tt.want)
+			}
+		})
+	}
+}
+
+func TestCacheLoadIgnoresCorruptFile(t *testing.T) {
+	f := newCacheFixture(t)
+	if err := os.WriteFile(f.cachePath, []byte("{not json"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	text := "let a = 1;\n"
+	target := f.write("a.ts", text, baseTime)
+	cache := f.load(StrategyMetadata)
+	if _, ok := cache.Lookup(target, "config-1", false); ok {
+		t.Error("Lookup() hit on a corrupt cache")
+	}
+	cache.RecordResult(Record{Path: target, Text: text, Config: "config-1"})
+	f.save(cache)
+	if _, ok := f.load(StrategyMetadata).Lookup(target, "config-1", false); !ok {
+		t.Error("Lookup() missed after rewriting a corrupt cache")
+	}
+}
@@ -36,6 +36,7 @@ type EslintPluginLintFile struct {
 	ConfigKey       string         `json:"configKey"`
 	LanguageOptions map[string]any `json:"languageOptions,omitempty"`
 	Settings        map[string]any `json:"settings,omitempty"`
+	NoInlineConfig  bool           `json:"noInlineConfig,omitempty"`
 }
 
 type EslintPluginLintRequest struct {
@@ -119,6 +120,9 @@ type EslintPluginFileInput struct {
 	ConfigKey       string
 	LanguageOptions map[string]any
 	Settings        map[string]any
+	// NoInlineConfig makes the worker ignore disable directives. It starts
+	// from the file's linterOptions; the CLI's --no-inline-config forces it.
+	NoInlineConfig bool
 	// Rules are the plugin rules (IsEslintPluginRule) configured for this
 	// file, carrying Name / Options / Severity.
 	Rules []rule.ConfiguredRule
@@ -140,7 +144,7 @@ func BuildEslintPluginFileInput(filePath, configKey string, rules []rule.Configu
 	if len(pluginRules) == 0 {
 		return EslintPluginFileInput{}, false
 	}
-	return EslintPluginFileInput{
+	input := EslintPluginFileInput{
 		Path:            filePath,
 		Text:            text,
 		SourceFile:      sourceFile,
@@ -148,7 +152,11 @@ func BuildEslintPluginFileInput(filePath, configKey string, rules []rule.Configu
 		LanguageOptions: languageOptions,
 		Settings:        settings,
 		Rules:           pluginRules,
-	}, true
+	}
+	if environment := pluginRules[0].Environment; environment != nil {
+		input.NoInlineConfig = environment.LinterOptions.NoInlineConfig
+	}
+	return input, true
 }
 
 // eslintPluginShutdownSentinel is the ONLY benign parseError the worker emits:
@@ -344,6 +352,7 @@ func buildEslintPluginRequest(batch []EslintPluginFileInput, fix bool, suggestio
 			ConfigKey:       f.ConfigKey,
 			LanguageOptions: f.LanguageOptions,
 			Settings:        f.Settings,
+			NoInlineConfig:  f.NoInlineConfig,
 		})
 	}
 	return EslintPluginLintRequest{
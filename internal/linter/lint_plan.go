@@ -33,6 +33,10 @@ type lintFilePlan struct {
 	rules          []rule.ConfiguredRule
 	environment    *rule.RuleEnvironment
 	hasTypeChecker bool
+	// inlineConfigDiagnostics are the problems found in the file's inline
+	// rule comments while resolving rules. They are reported with the file's
+	// native diagnostics.
+	inlineConfigDiagnostics []rule.RuleDiagnostic
 }
 
 type lintPlanFileRef struct {
@@ -158,16 +162,27 @@ func resolveProgramLintPlanFile(opts programPlanOptions, plan *programLintPlan,
 		return
 	}
 	rules := opts.GetRulesForFile(file)
-	// Program capability is the only checker gate at this boundary. Callers with
-	// a narrower request policy, such as LSP HasTypeInfo=false, filter the
-	// configured rule set before planning without inspecting Program adapters.
+	// Every configured rule of a file shares its config's environment. The
+	// environment also exists when the config enables no rule, and inline
+	// rule comments are resolved against it either way.
+	environment := firstNativeRuleEnvironment(rules)
+	if environment == nil && opts.GetEnvironmentForFile != nil {
+		environment = opts.GetEnvironmentForFile(file)
+	}
+	if environment != nil && !opts.NoInlineConfig && !environment.LinterOptions.NoInlineConfig {
+		rules, filePlan.inlineConfigDiagnostics = rule.ApplyInlineRuleConfigs(file, rule.NewCommentStore(file), rules, environment)
+	}
+	// Program capability is the only checker gate at this boundary. A caller
+	// with a narrower request policy, such as LintSingleFile without type
+	// info, narrows the planned rules afterwards so that rules enabled by
+	// inline comments are covered too.
 	filePlan.hasTypeChecker = opts.Program.CanProvideTypeChecker(file)
 	if filePlan.hasTypeChecker {
 		filePlan.rules = rules
 	} else {
 		filePlan.rules = rule.FilterNonTypeAwareRules(rules)
 	}
-	filePlan.environment = firstNativeRuleEnvironment(filePlan.rules)
+	filePlan.environment = environment
 }
 
 func firstNativeRuleEnvironment(rules []rule.ConfiguredRule) *rule.RuleEnvironment {
@@ -198,11 +213,41 @@ func (p *LintPlan) Targets() []LintTarget {
 	return targets
 }
 
+// PlannedFile is one selected file of a LintPlan with the Program that owns it.
+// Unlike LintTarget it includes syntax-error and zero-rule files, whose Rules
+// are empty.
+type PlannedFile struct {
+	Program *program.Program
+	File    *ast.SourceFile
+	Rules   []rule.ConfiguredRule
+}
+
+// Files returns every selected file in stable Program/file order. The rule
+// slices are shared immutable plan state and must be read-only.
+func (p *LintPlan) Files() []PlannedFile {
+	if p == nil {
+		return nil
+	}
+	var files []PlannedFile
+	for _, programPlan := range p.programs {
+		for _, filePlan := range programPlan.files {
+			files = append(files, PlannedFile{
+				Program: programPlan.program,
+				File:    filePlan.file,
+				Rules:   filePlan.rules,
+			})
+		}
+	}
+	return files
+}
+
 func programPlanOptionsFor(opts RunLinterOptions, programIndex int) programPlanOptions {
 	programOpts := programPlanOptions{
-		Program:         opts.Programs[programIndex],
-		ExcludePaths:    opts.ExcludePaths,
-		GetRulesForFile: opts.GetRulesForFile,
+		Program:               opts.Programs[programIndex],
+		ExcludePaths:          opts.ExcludePaths,
+		NoInlineConfig:        opts.NoInlineConfig,
+		GetRulesForFile:       opts.GetRulesForFile,
+		GetEnvironmentForFile: opts.GetEnvironmentForFile,
 	}
 
 	if programIndex < len(opts.PerProgramFilter) {
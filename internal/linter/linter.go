@@ -5,6 +5,7 @@ import (
 	"errors"
 	"os"
 	"runtime"
+	"slices"
 	"strings"
 	"time"
 
@@ -99,16 +100,23 @@ type programPlanOptions struct {
 	TargetFiles     []string
 	HasTargetFiles  bool
 	SkipSyntaxCheck bool
-	GetRulesForFile RuleHandler
+	// NoInlineConfig skips inline rule comments while resolving each file's
+	// rules (--no-inline-config).
+	NoInlineConfig        bool
+	GetRulesForFile       RuleHandler
+	GetEnvironmentForFile EnvironmentHandler
 }
 
 // programRunOptions contains the run-scoped sinks and scheduling knobs that do
 // not change a prepared plan's meaning.
 type programRunOptions struct {
-	Cwd                  string
-	CollectExecutedRules bool
-	SingleThreaded       bool
-	Timing               *TimingCollector
+	Cwd                             string
+	CollectExecutedRules            bool
+	SingleThreaded                  bool
+	Timing                          *TimingCollector
+	NoInlineConfig                  bool
+	OverrideUnusedDisableDirectives bool
+	UnusedDisableDirectiveSeverity  rule.DiagnosticSeverity
 }
 
 // Keep checker-free lint shards large enough to amortize their goroutine,
@@ -198,8 +206,11 @@ func runLintRulesInProgram(plan *programLintPlan, opts programRunOptions, consum
 	// comments, DisableManager, and rule contexts are per-file. The listener
 	// registry belongs to the calling checker-shard task and is empty on entry;
 	// reset clears all captured per-file state before the next serial file.
-	lintFile := func(filePlan *lintFilePlan, rules []rule.ConfiguredRule, chk *checker.Checker, registeredListeners *listenerRegistry) {
+	lintFile := func(filePlan *lintFilePlan, rules []rule.ConfiguredRule, hasPluginRules bool, chk *checker.Checker, registeredListeners *listenerRegistry) {
 		file := filePlan.file
+		for _, diagnostic := range filePlan.inlineConfigDiagnostics {
+			consumer.Report(diagnostic)
+		}
 
 		// Per-rule durations for this file, parallel to rules. Listeners are
 		// wrapped at registration time, so when timing is off the traversal
@@ -215,12 +226,24 @@ func runLintRulesInProgram(plan *programLintPlan, opts programRunOptions, consum
 		// comment-aware rule in this file. Most files never materialize it.
 		comments := rule.NewCommentStore(file)
 
+		var environment rule.RuleEnvironment
+		if filePlan.environment != nil {
+			environment = *filePlan.environment
+		}
+		linterOptions := effectiveLinterOptions(environment.LinterOptions, opts)
+
 		// Directive parsing is itself lazy and only runs when a rule reports.
 		disableManager := rule.NewDisableManager(file, comments)
 
 		// A cheap source-text check inside ParseInlineGlobals avoids asking
 		// the store for all comments unless an inline directive is possible.
-		inlineGlobals, inlineGlobalDeclarations := rule.ParseInlineGlobals(file, comments)
+		var inlineGlobals map[string]utils.GlobalAccess
+		var inlineGlobalDeclarations []rule.InlineGlobal
+		if linterOptions.NoInlineConfig {
+			disableManager.IgnoreDirectives()
+		} else {
+			inlineGlobals, inlineGlobalDeclarations = rule.ParseInlineGlobals(file, comments)
+		}
 
 		// Resolve immutable language initialization once per file. Globals and
 		// RefStore receive their own concrete data and never inspect the current
@@ -241,10 +264,6 @@ func runLintRulesInProgram(plan *programLintPlan, opts programRunOptions, consum
 		// rule asks about never does.
 		sourceBOM := rule.NewSourceBOM(sourceProgram.FS(), file.FileName())
 		fileCache := rule.NewFileCacheWithProcessCurrentDirectory(opts.Cwd)
-		var environment rule.RuleEnvironment
-		if filePlan.environment != nil {
-			environment = *filePlan.environment
-		}
 		baseContext := (rule.RuleContext{
 			SourceFile:      file,
 			Settings:        environment.Settings,
@@ -367,6 +386,7 @@ func runLintRulesInProgram(plan *programLintPlan, opts programRunOptions, consum
 			return false
 		}
 		file.Node.ForEachChild(childVisitor)
+		reportDirectiveProblems(file, comments, disableManager, linterOptions, rules, hasPluginRules, opts, consumer)
 		if opts.Timing != nil {
 			opts.Timing.addFile(file.FileName(), rules, ruleDurations)
 		}
@@ -396,8 +416,9 @@ func runLintRulesInProgram(plan *programLintPlan, opts programRunOptions, consum
 	// checker it acquired exclusively for its own shard.
 	ctx := context.Background()
 	type lintFileTask struct {
-		plan  *lintFilePlan
-		rules []rule.ConfiguredRule
+		plan           *lintFilePlan
+		rules          []rule.ConfiguredRule
+		hasPluginRules bool
 	}
 	checkerGroups := make(map[*checker.Checker][]lintFileTask)
 	checkerFreeGeneration := true
@@ -416,11 +437,25 @@ func runLintRulesInProgram(plan *programLintPlan, opts programRunOptions, consum
 				result.executedRules[configuredRule.Name] = struct{}{}
 			}
 		}
-		rules = filterNativeRules(rules)
-		if len(rules) == 0 {
+		nativeRules := filterNativeRules(rules)
+		if len(nativeRules) == 0 {
+			for _, diagnostic := range filePlan.inlineConfigDiagnostics {
+				consumer.Report(diagnostic)
+			}
+			// Nothing to traverse, but the file's linterOptions still judge
+			// its directive comments.
+			if filePlan.environment != nil {
+				comments := rule.NewCommentStore(file)
+				disableManager := rule.NewDisableManager(file, comments)
+				linterOptions := effectiveLinterOptions(filePlan.environment.LinterOptions, opts)
+				if linterOptions.NoInlineConfig {
+					disableManager.IgnoreDirectives()
+				}
+				reportDirectiveProblems(file, comments, disableManager, linterOptions, nil, len(rules) > 0, opts, consumer)
+			}
 			continue
 		}
-		task := lintFileTask{plan: filePlan, rules: rules}
+		task := lintFileTask{plan: filePlan, rules: nativeRules, hasPluginRules: len(nativeRules) < len(rules)}
 		if !filePlan.hasTypeChecker {
 			checkerGroups[nil] = append(checkerGroups[nil], task)
 			continue
@@ -440,7 +475,7 @@ func runLintRulesInProgram(plan *programLintPlan, opts programRunOptions, consum
 				defer done()
 			}
 			for _, task := range tasks {
-				lintFile(task.plan, task.rules, chk, &registeredListeners)
+				lintFile(task.plan, task.rules, task.hasPluginRules, chk, &registeredListeners)
 			}
 		})
 	}
@@ -496,6 +531,53 @@ func filterNativeRules(rules []rule.ConfiguredRule) []rule.ConfiguredRule {
 	return nativeRules
 }
 
+// effectiveLinterOptions applies the run's command-line overrides to a
+// file's configured linterOptions.
+func effectiveLinterOptions(configured rule.LinterOptions, opts programRunOptions) rule.LinterOptions {
+	if opts.NoInlineConfig {
+		configured.NoInlineConfig = true
+	}
+	if opts.OverrideUnusedDisableDirectives {
+		configured.ReportUnusedDisableDirectives = opts.UnusedDisableDirectiveSeverity != rule.SeverityOff
+		configured.UnusedDisableDirectiveSeverity = opts.UnusedDisableDirectiveSeverity
+	}
+	return configured
+}
+
+// reportDirectiveProblems reports, once a file's rules have run, the
+// directive comments that had no effect: all of them when linterOptions turn
+// inline configuration off, otherwise the unused disable directives when
+// those are requested.
+func reportDirectiveProblems(file *ast.SourceFile, comments *rule.CommentStore, disableManager *rule.DisableManager, linterOptions rule.LinterOptions, rules []rule.ConfiguredRule, hasPluginRules bool, opts programRunOptions, consumer rule.DiagnosticConsumer) {
+	if linterOptions.NoInlineConfig {
+		// --no-inline-config asked for the comments to be ignored; only
+		// the config setting warns that they have no effect.
+		if !opts.NoInlineConfig {
+			for _, diagnostic := range rule.IgnoredInlineConfigDiagnostics(file, comments) {
+				consumer.Report(diagnostic)
+			}
+		}
+	} else if linterOptions.ReportUnusedDisableDirectives {
+		reportUnusedDisableDirectives(disableManager, rules, hasPluginRules, linterOptions.UnusedDisableDirectiveSeverity, consumer)
+	}
+}
+
+// reportUnusedDisableDirectives reports the file's disable directives that
+// suppressed nothing. Only rules that ran natively here can be judged, and a
+// wildcard directive may have suppressed an ESLint-plugin diagnostic in the
+// Node worker, so wildcards are left alone when plugin rules are configured.
+func reportUnusedDisableDirectives(disableManager *rule.DisableManager, rules []rule.ConfiguredRule, hasPluginRules bool, severity rule.DiagnosticSeverity, consumer rule.DiagnosticConsumer) {
+	isChecked := func(ruleName string) bool {
+		return slices.ContainsFunc(rules, func(r rule.ConfiguredRule) bool { return r.Name == ruleName })
+	}
+	for _, diagnostic := range disableManager.UnusedDirectiveDiagnostics(severity, isChecked, !hasPluginRules) {
+		if consumer.Demand&rule.EditDemandAutofix == 0 {
+			diagnostic.FixesPtr = nil
+		}
+		consumer.Report(diagnostic)
+	}
+}
+
 func shouldSkipRulesForSyntax(opts programPlanOptions, file *ast.SourceFile, ctx context.Context) bool {
 	if opts.SkipSyntaxCheck {
 		return false
@@ -575,10 +657,13 @@ func RunLinter(opts RunLinterOptions) (*LintResult, error) {
 			}
 		}
 		runOpts := programRunOptions{
-			Cwd:                  opts.Cwd,
-			CollectExecutedRules: true,
-			SingleThreaded:       opts.SingleThreaded,
-			Timing:               opts.Timing,
+			Cwd:                             opts.Cwd,
+			CollectExecutedRules:            true,
+			SingleThreaded:                  opts.SingleThreaded,
+			Timing:                          opts.Timing,
+			NoInlineConfig:                  opts.NoInlineConfig,
+			OverrideUnusedDisableDirectives: opts.OverrideUnusedDisableDirectives,
+			UnusedDisableDirectiveSeverity:  opts.UnusedDisableDirectiveSeverity,
 		}
 		programResults := make([]programLintResult, len(opts.Programs))
 		wg := core.NewWorkGroup(opts.SingleThreaded)
@@ -723,32 +808,38 @@ func LintSingleFile(opts LintSingleFileOptions) {
 		panic("linter: invalid native edit demand")
 	}
 	consumer := normalizeDiagnosticConsumer(opts.Consumer)
-	getRulesForFile := opts.GetRulesForFile
-	if getRulesForFile == nil {
+	if opts.GetRulesForFile == nil {
 		return
 	}
-	if !opts.HasTypeInfo {
-		base := getRulesForFile
-		getRulesForFile = func(file *ast.SourceFile) []rule.ConfiguredRule {
-			return rule.FilterNonTypeAwareRules(base(file))
-		}
-	}
 	plan, err := prepareProgramLintPlan(programPlanOptions{
-		Program:         opts.Program,
-		ExcludePaths:    opts.ExcludePaths,
-		TargetFiles:     []string{opts.File},
-		HasTargetFiles:  true,
-		SkipSyntaxCheck: true,
-		GetRulesForFile: getRulesForFile,
+		Program:               opts.Program,
+		ExcludePaths:          opts.ExcludePaths,
+		TargetFiles:           []string{opts.File},
+		HasTargetFiles:        true,
+		SkipSyntaxCheck:       true,
+		NoInlineConfig:        opts.NoInlineConfig,
+		GetRulesForFile:       opts.GetRulesForFile,
+		GetEnvironmentForFile: opts.GetEnvironmentForFile,
 	})
 	if err != nil {
 		panic(err)
 	}
+	if !opts.HasTypeInfo {
+		// Filtered after planning so that type-aware rules enabled by inline
+		// rule comments are dropped as well.
+		for fileIndex := range plan.files {
+			filePlan := &plan.files[fileIndex]
+			filePlan.rules = rule.FilterNonTypeAwareRules(filePlan.rules)
+		}
+	}
 	runLintRulesInProgram(&plan, programRunOptions{
 		Cwd: opts.Cwd,
 		// A single file is a single shard — run it on the calling goroutine
 		// instead of scheduling a background task.
-		SingleThreaded: true,
+		SingleThreaded:                  true,
+		NoInlineConfig:                  opts.NoInlineConfig,
+		OverrideUnusedDisableDirectives: opts.OverrideUnusedDisableDirectives,
+		UnusedDisableDirectiveSeverity:  opts.UnusedDisableDirectiveSeverity,
 	}, consumer)
 }
 
@@ -144,6 +144,90 @@ func TestRunLinter_DoesNotExecutePluginPlaceholderInNativePass(t *testing.T) {
 	}
 }
 
+func TestRunLinter_DirectivesInFilesWithoutRules(t *testing.T) {
+	program, paths := createTestProgramWithFiles(t, map[string]string{
+		"quiet.ts":   "// eslint-disable-next-line\nconst a = 1;\n",
+		"inline.ts":  "/* global foo */\nconst b = foo;\n",
+		"plugins.ts": "// eslint-disable-next-line\nconst c = 1;\n",
+	})
+	environments := map[string]*rule.RuleEnvironment{
+		paths["quiet.ts"]:   {LinterOptions: rule.LinterOptions{ReportUnusedDisableDirectives: true, UnusedDisableDirectiveSeverity: rule.SeverityWarning}},
+		paths["inline.ts"]:  {LinterOptions: rule.LinterOptions{NoInlineConfig: true}},
+		paths["plugins.ts"]: {LinterOptions: rule.LinterOptions{ReportUnusedDisableDirectives: true, UnusedDisableDirectiveSeverity: rule.SeverityWarning}},
+	}
+
+	var diagnostics []rule.RuleDiagnostic
+	_, err := RunLinter(RunLinterOptions{
+		Programs:       wrapTestPrograms(program),
+		SingleThreaded: true,
+		TargetFiles:    [][]string{{paths["quiet.ts"], paths["inline.ts"], paths["plugins.ts"]}},
+		GetRulesForFile: func(sf *ast.SourceFile) []ConfiguredRule {
+			if sf.FileName() != paths["plugins.ts"] {
+				return nil
+			}
+			// A wildcard directive may have suppressed a plugin diagnostic.
+			return []ConfiguredRule{{Name: "community/example", IsEslintPluginRule: true}}
+		},
+		GetEnvironmentForFile: func(sf *ast.SourceFile) *rule.RuleEnvironment {
+			return environments[sf.FileName()]
+		},
+		Consumer: rule.DiagnosticConsumer{Report: func(d rule.RuleDiagnostic) {
+			diagnostics = append(diagnostics, d)
+		}},
+	})
+	if err != nil {
+		t.Fatalf("RunLinter error: %v", err)
+	}
+
+	got := make(map[string]string, len(diagnostics))
+	for _, d := range diagnostics {
+		got[d.FilePath] = d.RuleName
+	}
+	want := map[string]string{
+		paths["quiet.ts"]:  rule.UnusedDisableDirectiveRuleName,
+		paths["inline.ts"]: rule.IgnoredInlineConfigRuleName,
+	}
+	if len(diagnostics) != len(want) || !reflect.DeepEqual(got, want) {
+		t.Errorf("diagnostics = %+v, want one per file in %v", diagnostics, want)
+	}
+}
+
+func TestRunLinter_InlineRuleConfigWithoutConfiguredRules(t *testing.T) {
+	program, paths := createTestProgramWithFiles(t, map[string]string{
+		"inline.ts": "/* eslint test-rule: \"error\" */\nconst a = 1;\n",
+	})
+	environment := &rule.RuleEnvironment{}
+	environment.InlineRules = func(name string, value any) (rule.InlineRuleResolution, error) {
+		configured := noopRule()[0]
+		configured.Severity = rule.SeverityError
+		configured.Environment = environment
+		return rule.InlineRuleResolution{Enabled: true, Rule: configured}, nil
+	}
+
+	var diagnostics []rule.RuleDiagnostic
+	_, err := RunLinter(RunLinterOptions{
+		Programs:        wrapTestPrograms(program),
+		SingleThreaded:  true,
+		TargetFiles:     [][]string{{paths["inline.ts"]}},
+		GetRulesForFile: func(*ast.SourceFile) []ConfiguredRule { return nil },
+		GetEnvironmentForFile: func(*ast.SourceFile) *rule.RuleEnvironment {
+			return environment
+		},
+		Consumer: rule.DiagnosticConsumer{Report: func(d rule.RuleDiagnostic) {
+			diagnostics = append(diagnostics, d)
+		}},
+	})
+	if err != nil {
+		t.Fatalf("RunLinter error: %v", err)
+	}
+
+	// The comment enables the rule although the config enables none; it
+	// reports the single identifier `a`.
+	if len(diagnostics) != 1 || diagnostics[0].RuleName != "test-rule" || diagnostics[0].Severity != rule.SeverityError {
+		t.Errorf("diagnostics = %+v, want one error from the inline-enabled rule", diagnostics)
+	}
+}
+
 func TestRunLinter_GlobalDeclarationMetadata(t *testing.T) {
 	source := "#!/usr/bin/env node\n" +
 		"/*global configOn:off, inlineOn, repeated:off */\n" +
@@ -3,6 +3,7 @@ package linter
 import (
 	"sync"
 
+	"github.com/microsoft/typescript-go/shim/ast"
 	"github.com/microsoft/typescript-go/shim/core"
 )
 
@@ -20,6 +21,12 @@ func newTextSourceFile(text string) *textSourceFile {
 	return &textSourceFile{text: text}
 }
 
+// NewTextSourceFile returns a text-only ast.SourceFileLike for diagnostics
+// replayed without a parse, such as results restored from the lint cache.
+func NewTextSourceFile(text string) ast.SourceFileLike {
+	return newTextSourceFile(text)
+}
+
 func (f *textSourceFile) Text() string { return f.text }
 
 func (f *textSourceFile) ECMALineMap() []core.TextPos {
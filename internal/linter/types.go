@@ -12,6 +12,7 @@ import (
 type ConfiguredRule = rule.ConfiguredRule
 
 type RuleHandler = func(sourceFile *ast.SourceFile) []rule.ConfiguredRule
+type EnvironmentHandler = func(sourceFile *ast.SourceFile) *rule.RuleEnvironment
 type DiagnosticHandler = func(diagnostic rule.RuleDiagnostic)
 
 // FileScope describes user-supplied "lint targets" (CLI args).
@@ -57,6 +58,9 @@ type LintResult struct {
 //     (for example config global ignores). Entries within the slice
 //     may be nil individually.
 //   - GetRulesForFile=nil                 → no lint rules executed
+//   - GetEnvironmentForFile=nil           → a file's environment comes only
+//     from its configured rules, so a file without enabled rules has no
+//     linterOptions and its directive comments are not checked
 //   - PreparedPlan=nil                    → RunLinter collects targets and
 //     resolves rules through GetRulesForFile during the lint phase. Callers
 //     that need the same resolved targets before native execution may build a
@@ -72,6 +76,11 @@ type LintResult struct {
 //     rule Run call and listener invocation is timed and accumulated into the
 //     collector, keyed by rule name. Callers may share one collector across
 //     multiple RunLinter invocations (e.g. --fix re-lint passes) to aggregate.
+//   - NoInlineConfig=false                → each file's linterOptions decide
+//     whether directive comments apply
+//   - OverrideUnusedDisableDirectives=false → each file's linterOptions decide
+//     whether unused disable directives are reported;
+//     UnusedDisableDirectiveSeverity is ignored
 //
 // Thread-safety: Consumer.Report is invoked from multiple goroutines
 // concurrently — Phase 1 fans out per program AND per file shard within
@@ -99,6 +108,9 @@ type RunLinterOptions struct {
 	TargetFiles [][]string
 
 	GetRulesForFile RuleHandler
+	// GetEnvironmentForFile returns the rule environment of a file's config
+	// when GetRulesForFile resolves no native rule for it.
+	GetEnvironmentForFile EnvironmentHandler
 	// PreparedPlan, when non-nil, must have been built from these same Phase 1
 	// options with PrepareLintPlan. RunLinter consumes its per-Program files and
 	// resolved rules without collecting targets or calling GetRulesForFile again.
@@ -113,6 +125,19 @@ type RunLinterOptions struct {
 	TypeCheck bool
 
 	Timing *TimingCollector
+
+	// NoInlineConfig ignores directive comments in every file, as if each
+	// file's linterOptions.noInlineConfig were set, but without the warnings
+	// that setting produces for the ignored comments.
+	NoInlineConfig bool
+	// OverrideUnusedDisableDirectives replaces every file's
+	// linterOptions.reportUnusedDisableDirectives with
+	// UnusedDisableDirectiveSeverity, where SeverityOff turns reporting off.
+	// Reported are the disable directives, or rule names within them, that
+	// suppressed no diagnostic of a natively executed rule. The diagnostics
+	// carry removal fixes when Consumer demands autofixes.
+	OverrideUnusedDisableDirectives bool
+	UnusedDisableDirectiveSeverity  rule.DiagnosticSeverity
 }
 
 // LintSingleFileOptions configures a single-file, single-program rule pass.
@@ -126,9 +151,17 @@ type LintSingleFileOptions struct {
 	// Non-type-aware rules may still use the Program's checker for local analysis.
 	HasTypeInfo     bool
 	GetRulesForFile RuleHandler
-	ExcludePaths    []string
+	// GetEnvironmentForFile has the same meaning as on RunLinterOptions.
+	GetEnvironmentForFile EnvironmentHandler
+	ExcludePaths          []string
 	// Cwd has the same meaning as RunLinterOptions.Cwd.
 	Cwd string
 	// Consumer has the same native-only semantics as RunLinterOptions.Consumer.
 	Consumer rule.DiagnosticConsumer
+	// NoInlineConfig, OverrideUnusedDisableDirectives and
+	// UnusedDisableDirectiveSeverity have the same meaning as on
+	// RunLinterOptions.
+	NoInlineConfig                  bool
+	OverrideUnusedDisableDirectives bool
+	UnusedDisableDirectiveSeverity  rule.DiagnosticSeverity
 }
@@ -6,8 +6,13 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"strings"
 
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/core"
 	"github.com/microsoft/typescript-go/shim/lsp/lsproto"
+	"github.com/microsoft/typescript-go/shim/parser"
+	"github.com/microsoft/typescript-go/shim/tspath"
 
 	"github.com/web-infra-dev/rslint/internal/config"
 	"github.com/web-infra-dev/rslint/internal/linter"
@@ -134,11 +139,39 @@ func (s *Server) buildPluginFileInputWithSnapshot(
 
 	// sourceFile=nil: the LSP rebuilds against the overlay Text (the worker
 	// linted that same string). Shared filter/assembly with the CLI (F1).
+	enabledRules = s.applyInlineRuleConfigs(filePath, text, enabledRules, snapshot.resolvedConfig.Environment)
 	enabledRules = s.pluginRulesForCurrentGeneration(enabledRules)
 	languageOptions, settings := config.PluginMergedMaps(merged)
 	return linter.BuildEslintPluginFileInput(filePath, configKey, enabledRules, languageOptions, settings, text, nil)
 }
 
+// applyInlineRuleConfigs applies the document's `/* eslint name: value */`
+// comments to rules, as the native pass's lint plan does for the same text,
+// so that plugin rules are configured identically by the CLI, the API, and the
+// editor. Problems in the comments are dropped here; the native pass reports
+// them.
+func (s *Server) applyInlineRuleConfigs(filePath string, text *string, rules []rule.ConfiguredRule, environment *rule.RuleEnvironment) []rule.ConfiguredRule {
+	if environment == nil || environment.InlineRules == nil || environment.LinterOptions.NoInlineConfig {
+		return rules
+	}
+	var content string
+	if text != nil {
+		content = *text
+	} else if onDisk, ok := s.fs.ReadFile(filePath); ok {
+		content = onDisk
+	}
+	// Parsing is only worth it when the text can hold an inline config.
+	if !strings.Contains(content, "slint") {
+		return rules
+	}
+	sourceFile := parser.ParseSourceFile(ast.SourceFileParseOptions{
+		FileName: filePath,
+		Path:     tspath.Path(filePath),
+	}, content, core.GetScriptKindFromFileName(filePath))
+	rules, _ = rule.ApplyInlineRuleConfigs(sourceFile, rule.NewCommentStore(sourceFile), rules, environment)
+	return rules
+}
+
 // eslintPluginRuleSet expands activation metadata into the exact rule names
 // the matching Node generation can execute. It always returns a non-nil map:
 // an activated generation with no community plugins must block placeholders
@@ -6,6 +6,7 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"reflect"
 	"strings"
 	"sync"
 	"testing"
@@ -285,6 +286,48 @@ func TestBuildPluginFileInput_TextOverridePrecedence(t *testing.T) {
 	}
 }
 
+// TestBuildPluginFileInput_AppliesInlineRuleConfig pins that inline rule
+// comments configure plugin rules in the editor exactly as the CLI's lint
+// plan does.
+func TestBuildPluginFileInput_AppliesInlineRuleConfig(t *testing.T) {
+	config.RegisterEslintPluginRules([]config.EslintPluginEntry{
+		{Prefix: "tplinline", RuleNames: []string{"no-foo", "no-bar"}},
+	})
+
+	s := newTestServer()
+	installJSConfigsForTest(s, map[string]config.RslintConfig{
+		"/proj": {
+			{
+				Plugins: []string{"tplinline"},
+				Rules:   config.Rules{"tplinline/no-foo": "error"},
+			},
+		},
+	})
+	uri := lsproto.DocumentUri("file:///proj/a.ts")
+
+	s.documents[uri] = "/* eslint tplinline/no-foo: \"warn\", tplinline/no-bar: \"error\" */\n"
+	in, ok := s.buildPluginFileInput(uri, nil)
+	if !ok {
+		t.Fatal("expected ok=true (file has plugin rules)")
+	}
+	severities := make(map[string]rule.DiagnosticSeverity, len(in.Rules))
+	for _, configured := range in.Rules {
+		severities[configured.Name] = configured.Severity
+	}
+	want := map[string]rule.DiagnosticSeverity{
+		"tplinline/no-foo": rule.SeverityWarning,
+		"tplinline/no-bar": rule.SeverityError,
+	}
+	if !reflect.DeepEqual(severities, want) {
+		t.Errorf("plugin rules = %v, want %v", severities, want)
+	}
+
+	s.documents[uri] = "/* eslint tplinline/no-foo: off */\n"
+	if in, ok := s.buildPluginFileInput(uri, nil); ok {
+		t.Errorf("expected no dispatch once the comment turns the only plugin rule off, got %+v", in.Rules)
+	}
+}
+
 func TestBuildPluginFileInput_RespectsFiles(t *testing.T) {
 	config.RegisterEslintPluginRules([]config.EslintPluginEntry{
 		{Prefix: "tplfiles", RuleNames: []string{"no-foo"}},
@@ -1521,3 +1564,23 @@ func TestHandleDidClose_CancelsInflightDispatch(t *testing.T) {
 		t.Error("in-flight dispatch entry should be removed after close")
 	}
 }
+
+func TestConvertRuleDiagnosticToLSP_LinksRuleDocumentation(t *testing.T) {
+	config.RegisterAllRules()
+	text := "debugger;"
+
+	native := convertRuleDiagnosticToLSP(pluginDiag(text, "no-debugger", "msg", 0, 8))
+	if native.Code == nil || native.Code.String == nil || *native.Code.String != "no-debugger" {
+		t.Fatalf("code = %+v, want the rule name", native.Code)
+	}
+	if native.CodeDescription == nil || string(native.CodeDescription.Href) != rule.DocsURL("no-debugger") {
+		t.Fatalf("codeDescription = %+v, want the rule's documentation page", native.CodeDescription)
+	}
+
+	// Rules the registry does not know, such as ESLint plugin rules, carry no
+	// documentation link.
+	unknown := convertRuleDiagnosticToLSP(pluginDiag(text, "plug/no-foo", "msg", 0, 8))
+	if unknown.Code != nil || unknown.CodeDescription != nil {
+		t.Fatalf("unknown rule code = %+v, codeDescription = %+v", unknown.Code, unknown.CodeDescription)
+	}
+}
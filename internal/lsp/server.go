@@ -39,6 +39,12 @@ type ServerOptions struct {
 	TypingsLocation    string
 
 	ParseCache *project.ParseCache
+
+	// OverrideUnusedDisableDirectives replaces every file's
+	// linterOptions.reportUnusedDisableDirectives with
+	// UnusedDisableDirectiveSeverity.
+	OverrideUnusedDisableDirectives bool
+	UnusedDisableDirectiveSeverity  rule.DiagnosticSeverity
 }
 
 func NewServer(opts *ServerOptions) *Server {
@@ -69,6 +75,10 @@ func NewServer(opts *ServerOptions) *Server {
 		docGeneration:          make(map[lsproto.DocumentUri]uint64),
 		inflightPluginDispatch: make(map[lsproto.DocumentUri]*pluginDispatchHandle),
 		lintSessionRoots:       newLintSessionProjectRootCache(),
+		unusedDirectives: unusedDirectiveOverride{
+			set:      opts.OverrideUnusedDisableDirectives,
+			severity: opts.UnusedDisableDirectiveSeverity,
+		},
 	}
 }
 
@@ -165,6 +175,8 @@ type Server struct {
 	// enables tests to share a cache of parsed source files
 	parseCache *project.ParseCache
 
+	unusedDirectives unusedDirectiveOverride
+
 	// !!! temporary; remove when we have `handleDidChangeConfiguration`/implicit project config support
 	compilerOptionsForInferredProjects *core.CompilerOptions
 
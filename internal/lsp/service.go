@@ -57,6 +57,15 @@ type documentLintSnapshot struct {
 	typeScriptConfigPaths []string
 	usesJavaScriptConfig  bool
 	unavailable           bool
+	unusedDirectives      unusedDirectiveOverride
+}
+
+// unusedDirectiveOverride is the --report-unused-disable-directives-severity
+// the server was started with. When set is false each file's
+// linterOptions.reportUnusedDisableDirectives decides.
+type unusedDirectiveOverride struct {
+	set      bool
+	severity rule.DiagnosticSeverity
 }
 
 func resolveDocumentLintSnapshotConfig(
@@ -961,7 +970,7 @@ func convertRuleDiagnosticToLSP(ruleDiag rule.RuleDiagnostic) *lsproto.Diagnosti
 	startLine, startColumn := scanner.GetECMALineAndUTF16CharacterOfPosition(ruleDiag.SourceFile, diagnosticStart)
 	endLine, endColumn := scanner.GetECMALineAndUTF16CharacterOfPosition(ruleDiag.SourceFile, diagnosticEnd)
 
-	return &lsproto.Diagnostic{
+	diagnostic := &lsproto.Diagnostic{
 		Range: lsproto.Range{
 			Start: lsproto.Position{
 				Line:      uint32(startLine),
@@ -976,6 +985,15 @@ func convertRuleDiagnosticToLSP(ruleDiag rule.RuleDiagnostic) *lsproto.Diagnosti
 		Source:   ptrTo("rslint"),
 		Message:  lsproto.StringOrMarkupContent{String: ptrTo(fmt.Sprintf("[%s] %s", ruleDiag.RuleName, ruleDiag.Message.Description))},
 	}
+	// The rule name as the diagnostic code lets editors link it to the rule's
+	// documentation page.
+	if ruleImpl, exists := config.GlobalRuleRegistry.GetRule(ruleDiag.RuleName); exists {
+		diagnostic.Code = &lsproto.IntegerOrString{String: ptrTo(ruleDiag.RuleName)}
+		if ruleImpl.Meta.DocsURL != "" {
+			diagnostic.CodeDescription = &lsproto.CodeDescription{Href: lsproto.URI(ruleImpl.Meta.DocsURL)}
+		}
+	}
+	return diagnostic
 }
 
 func isLintableScriptFile(uri lsproto.DocumentUri) bool {
@@ -1096,6 +1114,8 @@ func runLintWithProgramLoader(
 		processCwd,
 		hasTypeInfo,
 		snapshot.resolvedConfig.EnabledRules,
+		snapshot.resolvedConfig.Environment,
+		snapshot.unusedDirectives,
 		rule.EditDemandAll,
 		ctx,
 	), nil
@@ -1140,6 +1160,8 @@ func lintSingleFile(
 	processCwd string,
 	hasTypeInfo bool,
 	enabledRules []rule.ConfiguredRule,
+	environment *rule.RuleEnvironment,
+	unusedDirectives unusedDirectiveOverride,
 	editDemand rule.EditDemand,
 	ctx context.Context,
 ) lintPassResult {
@@ -1183,10 +1205,15 @@ func lintSingleFile(
 		GetRulesForFile: func(*ast.SourceFile) []rule.ConfiguredRule {
 			return rulesServedToEditors(enabledRules)
 		},
+		GetEnvironmentForFile: func(*ast.SourceFile) *rule.RuleEnvironment {
+			return environment
+		},
 		Consumer: rule.DiagnosticConsumer{
 			Demand: editDemand,
 			Report: diagnosticCollector,
 		},
+		OverrideUnusedDisableDirectives: unusedDirectives.set,
+		UnusedDisableDirectiveSeverity:  unusedDirectives.severity,
 	})
 
 	if diagnostics == nil {
@@ -1523,6 +1550,7 @@ func (s *Server) runConfiguredLintForContent(
 		config:                rslintConfig,
 		typeScriptConfigPaths: tsConfigPaths,
 		usesJavaScriptConfig:  enforcePlugins,
+		unusedDirectives:      s.unusedDirectives,
 	}, s.fs)
 	return s.runConfiguredLintForContentWithSnapshot(uri, ctx, content, snapshot)
 }
@@ -1568,6 +1596,8 @@ func (s *Server) runConfiguredLintForContentWithSnapshot(
 			s.cwd,
 			true,
 			snapshot.resolvedConfig.EnabledRules,
+			snapshot.resolvedConfig.Environment,
+			snapshot.unusedDirectives,
 			rule.EditDemandAutofix,
 			ctx,
 		), nil
@@ -1584,6 +1614,8 @@ func (s *Server) runConfiguredLintForContentWithSnapshot(
 		s.cwd,
 		false,
 		snapshot.resolvedConfig.EnabledRules,
+		snapshot.resolvedConfig.Environment,
+		snapshot.unusedDirectives,
 		rule.EditDemandAutofix,
 		ctx,
 	), nil
@@ -1690,9 +1722,16 @@ func createCodeActionFromSuggestion(ruleDiag rule.RuleDiagnostic, suggestion rul
 
 // Helper function to create disable rule actions for diagnostics without fixes
 func createDisableRuleActions(ruleDiag rule.RuleDiagnostic, uri lsproto.DocumentUri) []lsproto.CommandOrCodeAction {
+	// Problems in directive and inline config comments do not come from a rule
+	// that can be disabled; the comment itself has to change.
 	if ruleDiag.Origin == rule.DiagnosticOriginTypeScript {
 		return nil
 	}
+	switch ruleDiag.RuleName {
+	case rule.UnusedDisableDirectiveRuleName, rule.IgnoredInlineConfigRuleName,
+		rule.InlineConfigRuleName, rule.UnusedInlineConfigRuleName:
+		return nil
+	}
 	var actions []lsproto.CommandOrCodeAction
 
 	lspDiagnostic := convertRuleDiagnosticToLSP(ruleDiag)
@@ -1919,6 +1958,7 @@ func (s *Server) documentLintSnapshot(uri lsproto.DocumentUri) documentLintSnaps
 		typeScriptConfigPaths: typeScriptConfigPaths,
 		usesJavaScriptConfig:  selection.usesJSConfig,
 		unavailable:           selection.usesJSConfig && unavailable,
+		unusedDirectives:      s.unusedDirectives,
 	}
 }
 
@@ -5,11 +5,13 @@ import (
 	"encoding/json"
 )
 
-type jsonLineFormatter struct{}
+type jsonLineFormatter struct {
+	options Options
+}
 
 func (jsonLineFormatter) begin(_ *bufio.Writer, _ Report, _ bool) error { return nil }
 
-func (jsonLineFormatter) diagnostic(w *bufio.Writer, view diagnosticView) error {
+func (f jsonLineFormatter) diagnostic(w *bufio.Writer, view diagnosticView) error {
 	type jsonLocation struct {
 		Line   int `json:"line"`
 		Column int `json:"column"`
@@ -24,6 +26,7 @@ func (jsonLineFormatter) diagnostic(w *bufio.Writer, view diagnosticView) error
 		FilePath string    `json:"filePath"`
 		Range    jsonRange `json:"range"`
 		Severity string    `json:"severity"`
+		DocsURL  string    `json:"docsUrl,omitempty"`
 	}
 
 	diagnostic := jsonDiagnostic{
@@ -36,6 +39,9 @@ func (jsonLineFormatter) diagnostic(w *bufio.Writer, view diagnosticView) error
 		},
 		Severity: view.raw.Severity.String(),
 	}
+	if meta, ok := f.options.ruleMeta(view.raw.RuleName); ok {
+		diagnostic.DocsURL = meta.DocsURL
+	}
 
 	encoded, err := json.Marshal(diagnostic)
 	if err != nil {
@@ -16,6 +16,19 @@ type Options struct {
 	ComparePaths tspath.ComparePathsOptions
 	Quiet        bool
 	ColorEnabled bool
+	// Rules names every registered rule, for formats that publish a rule
+	// catalog alongside their results (SARIF).
+	Rules []string
+	// RuleMeta looks up the metadata of a rule by name. Formats use it to link
+	// diagnostics to rule documentation; nil disables the lookup.
+	RuleMeta func(name string) (rule.RuleMeta, bool)
+}
+
+func (o Options) ruleMeta(name string) (rule.RuleMeta, bool) {
+	if o.RuleMeta == nil {
+		return rule.RuleMeta{}, false
+	}
+	return o.RuleMeta(name)
 }
 
 type formatter interface {
@@ -76,11 +89,13 @@ func newFormatter(options Options) (formatter, error) {
 	case FormatDefault:
 		return &defaultFormatter{colors: newColorScheme(options.ColorEnabled)}, nil
 	case FormatJSONLine:
-		return jsonLineFormatter{}, nil
+		return jsonLineFormatter{options: options}, nil
 	case FormatGitHub:
 		return githubFormatter{}, nil
 	case FormatGitLab:
 		return newGitLabFormatter(), nil
+	case FormatSARIF:
+		return newSARIFFormatter(options), nil
 	default:
 		return nil, errors.New("unsupported output format " + options.Format.String())
 	}
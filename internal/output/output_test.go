@@ -18,6 +18,7 @@ import (
 	"github.com/microsoft/typescript-go/shim/tspath"
 	"github.com/microsoft/typescript-go/shim/vfs/cachedvfs"
 	"github.com/microsoft/typescript-go/shim/vfs/osvfs"
+	"github.com/web-infra-dev/rslint/internal/linter"
 	"github.com/web-infra-dev/rslint/internal/rule"
 	"github.com/web-infra-dev/rslint/internal/utils"
 )
@@ -31,6 +32,7 @@ func TestParseFormat(t *testing.T) {
 		{"jsonline", FormatJSONLine},
 		{"github", FormatGitHub},
 		{"gitlab", FormatGitLab},
+		{"sarif", FormatSARIF},
 	}
 	for _, test := range tests {
 		got, err := ParseFormat(test.value)
@@ -210,7 +212,7 @@ func TestMachineFormatsHaveNoLeadingBlankLine(t *testing.T) {
 	diagnostic, paths := createOutputTestDiagnostic(t, rule.SeverityWarning)
 	report := NewReport([]rule.RuleDiagnostic{diagnostic}, Metadata{Mode: ModeLint})
 
-	for _, format := range []Format{FormatJSONLine, FormatGitHub, FormatGitLab} {
+	for _, format := range []Format{FormatJSONLine, FormatGitHub, FormatGitLab, FormatSARIF} {
 		t.Run(format.String(), func(t *testing.T) {
 			var buf bytes.Buffer
 			if err := Render(&buf, report, Options{Format: format, ComparePaths: paths}); err != nil {
@@ -336,6 +338,217 @@ func TestGitLabEmptyAndFingerprintCollisions(t *testing.T) {
 	}
 }
 
+type sarifTestLog struct {
+	Version string `json:"version"`
+	Runs    []struct {
+		Tool struct {
+			Driver struct {
+				Name  string `json:"name"`
+				Rules []struct {
+					ID string `json:"id"`
+				} `json:"rules"`
+			} `json:"driver"`
+		} `json:"tool"`
+		OriginalURIBaseIDs map[string]struct {
+			URI string `json:"uri"`
+		} `json:"originalUriBaseIds"`
+		ColumnKind string `json:"columnKind"`
+		Results    []struct {
+			RuleID    string `json:"ruleId"`
+			RuleIndex int    `json:"ruleIndex"`
+			Level     string `json:"level"`
+			Message   struct {
+				Text string `json:"text"`
+			} `json:"message"`
+			Locations []struct {
+				PhysicalLocation struct {
+					ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+					Region           sarifRegion           `json:"region"`
+				} `json:"physicalLocation"`
+			} `json:"locations"`
+			Fixes []struct {
+				ArtifactChanges []struct {
+					ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+					Replacements     []struct {
+						DeletedRegion   sarifRegion `json:"deletedRegion"`
+						InsertedContent struct {
+							Text string `json:"text"`
+						} `json:"insertedContent"`
+					} `json:"replacements"`
+				} `json:"artifactChanges"`
+			} `json:"fixes"`
+		} `json:"results"`
+	} `json:"runs"`
+}
+
+func renderSARIFForTest(t *testing.T, diagnostics []rule.RuleDiagnostic, options Options) sarifTestLog {
+	t.Helper()
+	options.Format = FormatSARIF
+	var buf bytes.Buffer
+	if err := Render(&buf, NewReport(diagnostics, Metadata{}), options); err != nil {
+		t.Fatal(err)
+	}
+	var log sarifTestLog
+	if err := json.Unmarshal(buf.Bytes(), &log); err != nil {
+		t.Fatalf("invalid SARIF JSON: %v\n%s", err, buf.String())
+	}
+	if log.Version != "2.1.0" || len(log.Runs) != 1 {
+		t.Fatalf("SARIF log version=%q runs=%d, want 2.1.0 with one run", log.Version, len(log.Runs))
+	}
+	return log
+}
+
+func TestSARIFProtocol(t *testing.T) {
+	diagnostic, paths := createOutputTestDiagnostic(t, rule.SeverityWarning)
+	// Surrogate pairs before the range: SARIF columns count UTF-16 code units.
+	source := "/*\U0001F600\U0001F600*/ let value = 1;\n"
+	start := strings.Index(source, "let")
+	fixes := []rule.RuleFix{{Text: "const", Range: core.NewTextRange(start, start+len("let"))}}
+	diagnostic.SourceFile = linter.NewTextSourceFile(source)
+	diagnostic.Range = core.NewTextRange(start, start+len("let value = 1;"))
+	diagnostic.RuleName = "prefer-const"
+	diagnostic.Message.Description = "Use const."
+	diagnostic.FixesPtr = &fixes
+	typeError, _ := createOutputTestDiagnostic(t, rule.SeverityError)
+	typeError.FilePath = diagnostic.FilePath
+	typeError.RuleName = "TypeScript(TS2322)"
+
+	log := renderSARIFForTest(t, []rule.RuleDiagnostic{diagnostic, typeError}, Options{
+		ComparePaths: paths,
+		Rules:        []string{"no-debugger", "prefer-const"},
+	})
+	run := log.Runs[0]
+	if run.Tool.Driver.Name != "rslint" || run.ColumnKind != "utf16CodeUnits" {
+		t.Fatalf("driver=%q columnKind=%q", run.Tool.Driver.Name, run.ColumnKind)
+	}
+	var ruleIDs []string
+	for _, descriptor := range run.Tool.Driver.Rules {
+		ruleIDs = append(ruleIDs, descriptor.ID)
+	}
+	if want := []string{"no-debugger", "prefer-const", "TypeScript(TS2322)"}; !slices.Equal(ruleIDs, want) {
+		t.Fatalf("rule table = %q, want %q", ruleIDs, want)
+	}
+	if root := run.OriginalURIBaseIDs[sarifSourceRoot].URI; !strings.HasPrefix(root, "file:///") || !strings.HasSuffix(root, "/") {
+		t.Fatalf("%s = %q, want a directory file URI", sarifSourceRoot, root)
+	}
+	if len(run.Results) != 2 {
+		t.Fatalf("results = %d, want 2", len(run.Results))
+	}
+
+	result := run.Results[0]
+	if result.RuleID != "prefer-const" || result.RuleIndex != 1 || result.Level != "warning" || result.Message.Text != "Use const." {
+		t.Fatalf("result = %+v", result)
+	}
+	location := result.Locations[0].PhysicalLocation
+	wantArtifact := sarifArtifactLocation{URI: "index.ts", URIBaseID: sarifSourceRoot}
+	if location.ArtifactLocation != wantArtifact {
+		t.Fatalf("artifact = %+v, want %+v", location.ArtifactLocation, wantArtifact)
+	}
+	if want := (sarifRegion{StartLine: 1, StartColumn: 10, EndLine: 1, EndColumn: 24}); location.Region != want {
+		t.Fatalf("region = %+v, want %+v", location.Region, want)
+	}
+	if len(result.Fixes) != 1 || len(result.Fixes[0].ArtifactChanges) != 1 {
+		t.Fatalf("fixes = %+v, want one fix with one artifact change", result.Fixes)
+	}
+	change := result.Fixes[0].ArtifactChanges[0]
+	if change.ArtifactLocation != wantArtifact || len(change.Replacements) != 1 {
+		t.Fatalf("artifact change = %+v", change)
+	}
+	replacement := change.Replacements[0]
+	if want := (sarifRegion{StartLine: 1, StartColumn: 10, EndLine: 1, EndColumn: 13}); replacement.DeletedRegion != want || replacement.InsertedContent.Text != "const" {
+		t.Fatalf("replacement = %+v", replacement)
+	}
+
+	if typeResult := run.Results[1]; typeResult.RuleIndex != 2 || typeResult.Level != "error" || typeResult.Fixes != nil {
+		t.Fatalf("type result = %+v", typeResult)
+	}
+}
+
+func TestSARIFEmptyAndQuiet(t *testing.T) {
+	warning, paths := createOutputTestDiagnostic(t, rule.SeverityWarning)
+	log := renderSARIFForTest(t, []rule.RuleDiagnostic{warning}, Options{ComparePaths: paths, Quiet: true})
+	if results := log.Runs[0].Results; results == nil || len(results) != 0 {
+		t.Fatalf("quiet results = %v, want an empty array", results)
+	}
+	if rules := log.Runs[0].Tool.Driver.Rules; rules == nil || len(rules) != 0 {
+		t.Fatalf("rule table = %v, want an empty array", rules)
+	}
+}
+
+func TestRuleMetaLinksDocumentation(t *testing.T) {
+	diagnostic, paths := createOutputTestDiagnostic(t, rule.SeverityWarning)
+	meta := rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		DocsURL:    "https://rslint.rs/rules/eslint/test-rule",
+		Fixable:    rule.FixableCode,
+		Deprecated: true,
+		ReplacedBy: []string{"other-rule"},
+	}
+	lookup := func(name string) (rule.RuleMeta, bool) {
+		return meta, name == "test-rule"
+	}
+
+	var buf bytes.Buffer
+	if err := Render(&buf, NewReport([]rule.RuleDiagnostic{diagnostic}, Metadata{}), Options{
+		Format: FormatJSONLine, ComparePaths: paths, RuleMeta: lookup,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if want := `"docsUrl":"` + meta.DocsURL + `"`; !strings.Contains(buf.String(), want) {
+		t.Fatalf("JSON line output = %q, want it to contain %s", buf.String(), want)
+	}
+
+	buf.Reset()
+	if err := Render(&buf, NewReport([]rule.RuleDiagnostic{diagnostic}, Metadata{}), Options{
+		Format: FormatSARIF, ComparePaths: paths, Rules: []string{"test-rule", "no-meta"}, RuleMeta: lookup,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	var log struct {
+		Runs []struct {
+			Tool struct {
+				Driver struct {
+					Rules []sarifReportingDescriptor `json:"rules"`
+				} `json:"driver"`
+			} `json:"tool"`
+		} `json:"runs"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &log); err != nil {
+		t.Fatalf("invalid SARIF JSON: %v\n%s", err, buf.String())
+	}
+	rules := log.Runs[0].Tool.Driver.Rules
+	if len(rules) != 2 {
+		t.Fatalf("rule table = %+v, want two descriptors", rules)
+	}
+	described := rules[0]
+	if described.HelpURI != meta.DocsURL || described.Properties == nil {
+		t.Fatalf("descriptor = %+v, want helpUri and properties", described)
+	}
+	if properties := described.Properties; properties.Type != "suggestion" || properties.Fixable != "code" ||
+		!properties.Deprecated || !slices.Equal(properties.ReplacedBy, meta.ReplacedBy) {
+		t.Fatalf("properties = %+v", properties)
+	}
+	if bare := rules[1]; bare.HelpURI != "" || bare.Properties != nil {
+		t.Fatalf("descriptor without metadata = %+v", bare)
+	}
+}
+
+func TestSARIFRejectsInvalidFixBeforeWriting(t *testing.T) {
+	diagnostic, paths := createOutputTestDiagnostic(t, rule.SeverityError)
+	fixes := []rule.RuleFix{{Text: "x", Range: core.NewTextRange(0, len(diagnostic.SourceFile.Text())+1)}}
+	diagnostic.FixesPtr = &fixes
+	var buf bytes.Buffer
+	err := Render(&buf, NewReport([]rule.RuleDiagnostic{diagnostic}, Metadata{}), Options{
+		Format: FormatSARIF, ComparePaths: paths,
+	})
+	if err == nil || !strings.Contains(err.Error(), "invalid fix range") {
+		t.Fatalf("Render error = %v", err)
+	}
+	if buf.Len() != 0 {
+		t.Fatalf("invalid report wrote partial output: %q", buf.String())
+	}
+}
+
 func TestRenderValidatesAllDiagnosticsBeforeWriting(t *testing.T) {
 	valid, paths := createOutputTestDiagnostic(t, rule.SeverityError)
 	start := valid.Range.Pos()
@@ -351,7 +564,7 @@ func TestRenderValidatesAllDiagnosticsBeforeWriting(t *testing.T) {
 		}},
 	}
 
-	for _, format := range []Format{FormatDefault, FormatJSONLine, FormatGitHub, FormatGitLab} {
+	for _, format := range []Format{FormatDefault, FormatJSONLine, FormatGitHub, FormatGitLab, FormatSARIF} {
 		for _, test := range tests {
 			t.Run(format.String()+"/"+test.name, func(t *testing.T) {
 				bad := valid
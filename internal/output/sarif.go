@@ -0,0 +1,291 @@
+package output
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/microsoft/typescript-go/shim/scanner"
+	"github.com/microsoft/typescript-go/shim/tspath"
+	"github.com/web-infra-dev/rslint/internal/rule"
+)
+
+const (
+	sarifVersion    = "2.1.0"
+	sarifSchema     = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifSourceRoot = "%SRCROOT%"
+)
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifArtifactLocation struct {
+	URI       string `json:"uri"`
+	URIBaseID string `json:"uriBaseId,omitempty"`
+}
+
+type sarifRegion struct {
+	StartLine   int `json:"startLine"`
+	StartColumn int `json:"startColumn"`
+	EndLine     int `json:"endLine"`
+	EndColumn   int `json:"endColumn"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           sarifRegion           `json:"region"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifArtifactContent struct {
+	Text string `json:"text"`
+}
+
+type sarifReplacement struct {
+	DeletedRegion   sarifRegion          `json:"deletedRegion"`
+	InsertedContent sarifArtifactContent `json:"insertedContent"`
+}
+
+type sarifArtifactChange struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Replacements     []sarifReplacement    `json:"replacements"`
+}
+
+type sarifFix struct {
+	ArtifactChanges []sarifArtifactChange `json:"artifactChanges"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	RuleIndex int             `json:"ruleIndex"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+	Fixes     []sarifFix      `json:"fixes,omitempty"`
+}
+
+type sarifReportingDescriptor struct {
+	ID         string                     `json:"id"`
+	HelpURI    string                     `json:"helpUri,omitempty"`
+	Properties *sarifDescriptorProperties `json:"properties,omitempty"`
+}
+
+// sarifDescriptorProperties carries the rule metadata SARIF has no dedicated
+// field for, in the descriptor's property bag.
+type sarifDescriptorProperties struct {
+	Type           string   `json:"type,omitempty"`
+	Fixable        string   `json:"fixable,omitempty"`
+	HasSuggestions bool     `json:"hasSuggestions,omitempty"`
+	Deprecated     bool     `json:"deprecated,omitempty"`
+	ReplacedBy     []string `json:"replacedBy,omitempty"`
+}
+
+type sarifDriver struct {
+	Name           string                     `json:"name"`
+	InformationURI string                     `json:"informationUri"`
+	Rules          []sarifReportingDescriptor `json:"rules"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifRun struct {
+	Tool               sarifTool                        `json:"tool"`
+	OriginalURIBaseIDs map[string]sarifArtifactLocation `json:"originalUriBaseIds,omitempty"`
+	ColumnKind         string                           `json:"columnKind"`
+	Results            []sarifResult                    `json:"results"`
+}
+
+type sarifLog struct {
+	Version string     `json:"version"`
+	Schema  string     `json:"$schema"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+// sarifFormatter writes a single SARIF 2.1.0 log with one run. Results are
+// collected and the log is written in finish: fix ranges are validated while
+// a result is built, and a malformed fix must not leave a truncated document.
+type sarifFormatter struct {
+	sourceRoot string
+	options    Options
+	rules      []sarifReportingDescriptor
+	ruleIndex  map[string]int
+	results    []sarifResult
+}
+
+// newSARIFFormatter seeds the driver's rule table with the registered rules so
+// consumers can show every rule the tool knows about, not only the ones that
+// fired. Diagnostics from unregistered sources, such as TypeScript, are added
+// to the table as they are reported.
+func newSARIFFormatter(options Options) *sarifFormatter {
+	f := &sarifFormatter{
+		options:   options,
+		rules:     make([]sarifReportingDescriptor, 0, len(options.Rules)),
+		ruleIndex: make(map[string]int, len(options.Rules)),
+		results:   []sarifResult{},
+	}
+	if options.ComparePaths.CurrentDirectory != "" {
+		f.sourceRoot = fileURI(tspath.EnsureTrailingDirectorySeparator(options.ComparePaths.CurrentDirectory))
+	}
+	for _, name := range options.Rules {
+		f.ruleIndexFor(name)
+	}
+	return f
+}
+
+func (f *sarifFormatter) ruleIndexFor(name string) int {
+	if index, ok := f.ruleIndex[name]; ok {
+		return index
+	}
+	index := len(f.rules)
+	descriptor := sarifReportingDescriptor{ID: name}
+	if meta, ok := f.options.ruleMeta(name); ok {
+		descriptor.HelpURI = meta.DocsURL
+		descriptor.Properties = &sarifDescriptorProperties{
+			Type:           string(meta.Type),
+			Fixable:        string(meta.Fixable),
+			HasSuggestions: meta.HasSuggestions,
+			Deprecated:     meta.Deprecated,
+			ReplacedBy:     meta.ReplacedBy,
+		}
+	}
+	f.rules = append(f.rules, descriptor)
+	f.ruleIndex[name] = index
+	return index
+}
+
+func (f *sarifFormatter) begin(_ *bufio.Writer, _ Report, _ bool) error { return nil }
+
+func (f *sarifFormatter) diagnostic(_ *bufio.Writer, view diagnosticView) error {
+	artifact := f.artifactLocation(view.relativePath)
+	result := sarifResult{
+		RuleID:    view.raw.RuleName,
+		RuleIndex: f.ruleIndexFor(view.raw.RuleName),
+		Level:     sarifLevel(view.raw.Severity),
+		Message:   sarifMessage{Text: view.raw.Message.Description},
+		Locations: []sarifLocation{{
+			PhysicalLocation: sarifPhysicalLocation{
+				ArtifactLocation: artifact,
+				Region:           newSARIFRegion(view.start, view.end),
+			},
+		}},
+	}
+
+	// rslint applies a diagnostic's edits together, so they form one fix with
+	// one artifact change.
+	if fixes := view.raw.Fixes(); len(fixes) > 0 {
+		change := sarifArtifactChange{
+			ArtifactLocation: artifact,
+			Replacements:     make([]sarifReplacement, 0, len(fixes)),
+		}
+		textLength := len(view.raw.SourceFile.Text())
+		for _, fix := range fixes {
+			start, end := fix.Range.Pos(), fix.Range.End()
+			if start < 0 || end < start || end > textLength {
+				return fmt.Errorf(
+					"diagnostic %q for %q has invalid fix range [%d,%d) for source length %d",
+					view.raw.RuleName,
+					view.raw.FilePath,
+					start,
+					end,
+					textLength,
+				)
+			}
+			change.Replacements = append(change.Replacements, sarifReplacement{
+				DeletedRegion: newSARIFRegion(
+					sourceLocation(view.raw, start),
+					sourceLocation(view.raw, end),
+				),
+				InsertedContent: sarifArtifactContent{Text: fix.Text},
+			})
+		}
+		result.Fixes = []sarifFix{{ArtifactChanges: []sarifArtifactChange{change}}}
+	}
+
+	f.results = append(f.results, result)
+	return nil
+}
+
+func (f *sarifFormatter) finish(w *bufio.Writer, _ Report) error {
+	run := sarifRun{
+		Tool: sarifTool{Driver: sarifDriver{
+			Name:           "rslint",
+			InformationURI: "https://rslint.rs",
+			Rules:          f.rules,
+		}},
+		ColumnKind: "utf16CodeUnits",
+		Results:    f.results,
+	}
+	if f.sourceRoot != "" {
+		run.OriginalURIBaseIDs = map[string]sarifArtifactLocation{
+			sarifSourceRoot: {URI: f.sourceRoot},
+		}
+	}
+
+	encoded, err := json.Marshal(sarifLog{
+		Version: sarifVersion,
+		Schema:  sarifSchema,
+		Runs:    []sarifRun{run},
+	})
+	if err != nil {
+		return err
+	}
+	w.Write(encoded)
+	w.WriteByte('\n')
+	return nil
+}
+
+// artifactLocation reports paths relative to the working directory against
+// %SRCROOT% and paths that could not be made relative as absolute file URIs.
+func (f *sarifFormatter) artifactLocation(relativePath string) sarifArtifactLocation {
+	if tspath.IsRootedDiskPath(relativePath) {
+		return sarifArtifactLocation{URI: fileURI(relativePath)}
+	}
+	location := sarifArtifactLocation{URI: (&url.URL{Path: relativePath}).EscapedPath()}
+	if f.sourceRoot != "" {
+		location.URIBaseID = sarifSourceRoot
+	}
+	return location
+}
+
+func fileURI(path string) string {
+	if !strings.HasPrefix(path, "/") {
+		// Windows drive paths become file:///C:/...
+		path = "/" + path
+	}
+	return (&url.URL{Scheme: "file", Path: path}).String()
+}
+
+func sourceLocation(diagnostic rule.RuleDiagnostic, position int) location {
+	line, column := scanner.GetECMALineAndUTF16CharacterOfPosition(diagnostic.SourceFile, position)
+	return location{line: line, column: int(column)}
+}
+
+// newSARIFRegion converts zero-based UTF-16 locations into SARIF's one-based
+// lines and columns.
+func newSARIFRegion(start location, end location) sarifRegion {
+	return sarifRegion{
+		StartLine:   start.line + 1,
+		StartColumn: start.column + 1,
+		EndLine:     end.line + 1,
+		EndColumn:   end.column + 1,
+	}
+}
+
+func sarifLevel(severity rule.DiagnosticSeverity) string {
+	switch severity {
+	case rule.SeverityError:
+		return "error"
+	case rule.SeverityWarning:
+		return "warning"
+	default:
+		return "note"
+	}
+}
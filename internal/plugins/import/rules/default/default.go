@@ -15,6 +15,10 @@ import (
 var DefaultRule = rule.Rule{
 	Name:   "import/default",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"noDefault"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		checkDefault := func(node *ast.Node) {
 			importDecl := node.AsImportDeclaration()
@@ -20,6 +20,11 @@ var schemaJSON []byte
 var FirstRule = rule.Rule{
 	Name:   "import/first",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"first", "absolute"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		// The linter visits SourceFile's children but never fires a KindSourceFile
 		// listener, so run the check eagerly before returning listeners.
@@ -24,6 +24,10 @@ type ruleOptions struct {
 var NamespaceRule = rule.Rule{
 	Name:   "import/namespace",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"noExports", "computed", "assignment", "notFound", "onlyTopLevel"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 		namespaces := collectNamespaces(ctx)
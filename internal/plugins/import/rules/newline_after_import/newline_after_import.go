@@ -54,6 +54,10 @@ func makeMessage(typ string, count int) rule.RuleMessage {
 var NewlineAfterImportRule = rule.Rule{
 	Name:   "import/newline-after-import",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:    rule.RuleTypeLayout,
+		Fixable: rule.FixableWhitespace,
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 
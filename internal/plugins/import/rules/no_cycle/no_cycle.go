@@ -33,6 +33,10 @@ type ruleOptions struct {
 var NoCycleRule = rule.Rule{
 	Name:   "import/no-cycle",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"cycle"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 		checkSourceFile(ctx, opts)
@@ -31,6 +31,10 @@ func noAliasDefaultMessage(local string) rule.RuleMessage {
 var NoDefaultExportRule = rule.Rule{
 	Name:   "import/no-default-export",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"preferNamed", "noAliasDefault"},
+	},
 	Run: func(ctx rule.RuleContext, _options []any) rule.RuleListeners {
 		reportDefaultExport := func(node *ast.Node) {
 			ctx.ReportRange(defaultKeywordRange(ctx.SourceFile, node), preferNamedMessage())
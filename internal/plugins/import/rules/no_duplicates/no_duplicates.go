@@ -36,6 +36,11 @@ func parseOptions(options []any) ruleOptions {
 var NoDuplicatesRule = rule.Rule{
 	Name:   "import/no-duplicates",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"noDuplicates"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 
@@ -12,6 +12,10 @@ import (
 var NoMutableExportsRule = rule.Rule{
 	Name:   "import/no-mutable-exports",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"noMutableExports"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		// checkDeclarationList reports if the VariableDeclarationList uses `let` or `var`.
 		checkDeclarationList := func(declList *ast.Node) {
@@ -45,6 +45,10 @@ type pathValidator struct {
 var NoRestrictedPathsRule = rule.Rule{
 	Name:   "import/no-restricted-paths",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"mixedGlobAndNonGlob", "invalidExceptionGlob", "invalidExceptionPath", "unexpectedPath"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 		if len(opts.zones) == 0 || !ctx.Program().IsValid() {
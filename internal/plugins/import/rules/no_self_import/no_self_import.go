@@ -10,6 +10,10 @@ import (
 var NoSelfImportRule = rule.Rule{
 	Name:   "import/no-self-import",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"import/no-self-import"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return utils.VisitModules(func(source, node *ast.Node) {
 			isImportingSelf(ctx, source, node)
@@ -24,6 +24,10 @@ func buildRuleMessage(modulePath string) rule.RuleMessage {
 var NoWebpackLoaderSyntax = rule.Rule{
 	Name:   "import/no-webpack-loader-syntax",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"import/no-webpack-loader-syntax"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindImportDeclaration: func(node *ast.Node) {
@@ -81,6 +81,10 @@ func maybeResetCountForFunctionLike(node *ast.Node, ctx rule.RuleContext, count
 var MaxExpectsRule = rule.Rule{
 	Name:   "jest/max-expects",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"exceededMaxAssertion"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 		count := 0
@@ -48,6 +48,10 @@ func buildErrorExceededMaxDepthMessage(depth, maxAllowed int) rule.RuleMessage {
 var MaxNestedDescribeRule = rule.Rule{
 	Name:   "jest/max-nested-describe",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"exceededMaxDepth"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 		describes := make([]*ast.Node, 0, 8)
@@ -35,6 +35,11 @@ var methodNames = map[string]string{
 var NoAliasMethodsRule = rule.Rule{
 	Name:   "jest/no-alias-methods",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"replaceAlias"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindCallExpression: func(node *ast.Node) {
@@ -45,6 +45,10 @@ func commentInnerText(sourceText string, comment *ast.CommentRange) string {
 var NoCommentedOutTestsRule = rule.Rule{
 	Name:   "jest/no-commented-out-tests",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"commentedTests"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		text := ctx.SourceFile.Text()
 		for _, comment := range ctx.Comments.All() {
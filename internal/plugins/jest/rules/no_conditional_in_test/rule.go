@@ -60,6 +60,10 @@ func isOutermostOptionalChain(node *ast.Node) bool {
 var NoConditionalInTestRule = rule.Rule{
 	Name:   "jest/no-conditional-in-test",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"conditionalInTest"},
+	},
 	Run: func(ctx rule.RuleContext, rawOptions []any) rule.RuleListeners {
 		opts := parseOptions(rawOptions)
 		inTestCase := false
@@ -72,6 +72,10 @@ func isInGlobalOrModuleScope(node *ast.Node) bool {
 var NoConfusingSetTimeoutRule = rule.Rule{
 	Name:   "jest/no-confusing-set-timeout",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"globalSetTimeout", "multipleSetTimeouts", "orderSetTimeout"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		seenJestTimeout := false
 		shouldEmitOrderSetTimeout := false
@@ -65,6 +65,11 @@ func bracketStyleCalleeReplacement(replacement string) string {
 var NoDeprecatedFunctionsRule = rule.Rule{
 	Name:   "jest/no-deprecated-functions",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"deprecatedFunction"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		depMap := deprecatedFunctions(utils.JestVersionMajor(utils.GetJestVersion(ctx)))
 		if len(depMap) == 0 {
@@ -60,6 +60,11 @@ func findCallbackArgument(callExpr *ast.CallExpression, jestFnCall *utils.Parsed
 var NoDoneCallbackRule = rule.Rule{
 	Name:   "jest/no-done-callback",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:           rule.RuleTypeSuggestion,
+		HasSuggestions: true,
+		MessageIds:     []string{"noDoneCallback", "suggestWrappingInPromise", "useAwaitInsteadOfCallback"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindCallExpression: func(node *ast.Node) {
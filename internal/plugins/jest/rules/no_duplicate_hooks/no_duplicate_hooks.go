@@ -21,6 +21,10 @@ func buildNoDuplicateHookMessage(hook string) rule.RuleMessage {
 var NoDuplicateHooksRule = rule.Rule{
 	Name:   "jest/no-duplicate-hooks",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"noDuplicateHook"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		hookContexts := []map[string]int{{}}
 
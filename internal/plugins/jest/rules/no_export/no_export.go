@@ -63,6 +63,10 @@ func hasExportModifier(node *ast.Node) bool {
 var NoExportRule = rule.Rule{
 	Name:   "jest/no-export",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"unexpectedExport"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		exportNodes := make([]*ast.Node, 0)
 		hasJestBlock := false
@@ -30,6 +30,11 @@ func buildErrorSuggestRemoveFocusMessage() rule.RuleMessage {
 var NoFocusedTestsRule = rule.Rule{
 	Name:   "jest/no-focused-tests",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:           rule.RuleTypeSuggestion,
+		HasSuggestions: true,
+		MessageIds:     []string{"focusedTest", "suggestRemoveFocus"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindCallExpression: func(node *ast.Node) {
@@ -58,6 +58,10 @@ func parseOptions(options []any) Options {
 var NoHooksRule = rule.Rule{
 	Name:   "jest/no-hooks",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"unexpectedHook"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 
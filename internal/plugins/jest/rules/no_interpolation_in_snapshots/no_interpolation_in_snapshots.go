@@ -16,6 +16,10 @@ func buildNoInterpolationMessage() rule.RuleMessage {
 var NoInterpolationInSnapshotsRule = rule.Rule{
 	Name:   "jest/no-interpolation-in-snapshots",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"noInterpolation"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindCallExpression: func(node *ast.Node) {
@@ -172,6 +172,11 @@ func reportJasmineAssignedProperty(node *ast.Node, ctx rule.RuleContext) {
 var NoJasmineGlobalsRule = rule.Rule{
 	Name:   "jest/no-jasmine-globals",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"illegalGlobal", "illegalFail", "illegalPending", "illegalMethod", "illegalJasmine"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindCallExpression: func(node *ast.Node) {
@@ -95,6 +95,10 @@ func isNestedJestFnCall(node *ast.Node) bool {
 var NoRestrictedJestMethodsRule = rule.Rule{
 	Name:   "jest/no-restricted-jest-methods",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"restrictedJestMethod", "restrictedJestMethodWithMessage"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		restrictedMethods := parseOptions(options)
 		if len(restrictedMethods) == 0 {
@@ -101,6 +101,10 @@ func isChainRestricted(chain string, restriction restrictedMatcher) bool {
 var NoRestrictedMatchersRule = rule.Rule{
 	Name:   "jest/no-restricted-matchers",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"restrictedChain", "restrictedChainWithMessage"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		restrictedMatchers := parseOptions(options)
 		if len(restrictedMatchers) == 0 {
@@ -21,6 +21,11 @@ func buildErrorUsePreferredNameMessage(preferredName string) rule.RuleMessage {
 var NoTestPrefixesRule = rule.Rule{
 	Name:   "jest/no-test-prefixes",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"usePreferredName"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindCallExpression: func(node *ast.Node) {
@@ -90,6 +90,11 @@ func hasPromiseExpectModifier(jestFnCall *jestUtils.ParsedJestFnCall) bool {
 var NoUnneededAsyncExpectFunctionRule = rule.Rule{
 	Name:   "jest/no-unneeded-async-expect-function",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"noAsyncWrapperForExpectedPromise"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindCallExpression: func(node *ast.Node) {
@@ -28,6 +28,10 @@ func buildPreferCalledWithMessage(matcherName string) rule.RuleMessage {
 var PreferCalledWithRule = rule.Rule{
 	Name:   "jest/prefer-called-with",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"preferCalledWith"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindCallExpression: func(node *ast.Node) {
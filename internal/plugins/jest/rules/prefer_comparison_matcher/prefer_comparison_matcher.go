@@ -62,6 +62,11 @@ func buildModifierText(jestFnCall *utils.ParsedJestFnCall) string {
 var PreferComparisonMatcherRule = rule.Rule{
 	Name:   "jest/prefer-comparison-matcher",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"useToBeComparison"},
+	},
 	Run: func(ctx rule.RuleContext, _options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindCallExpression: func(node *ast.Node) {
@@ -28,6 +28,10 @@ func recommendFn(jestFnCalls []jestUtils.JestFnType) string {
 var PreferEachRule = rule.Rule{
 	Name:   "jest/prefer-each",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"preferEach"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		jestFnCalls := make([]jestUtils.JestFnType, 0, 4)
 		inTestCaseCall := false
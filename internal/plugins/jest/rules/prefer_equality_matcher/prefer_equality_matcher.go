@@ -57,6 +57,11 @@ func buildModifierText(jestFnCall *utils.ParsedJestFnCall, addNotModifier bool)
 var PreferEqualityMatcherRule = rule.Rule{
 	Name:   "jest/prefer-equality-matcher",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:           rule.RuleTypeSuggestion,
+		HasSuggestions: true,
+		MessageIds:     []string{"useEqualityMatcher", "suggestEqualityMatcher"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindCallExpression: func(node *ast.Node) {
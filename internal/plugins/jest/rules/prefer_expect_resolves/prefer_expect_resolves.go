@@ -20,6 +20,11 @@ func buildExpectResolvesErrorMessage() rule.RuleMessage {
 var PreferExpectResolvesRule = rule.Rule{
 	Name:   "jest/prefer-expect-resolves",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"expectResolves"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindCallExpression: func(node *ast.Node) {
@@ -21,6 +21,10 @@ func buildReorderHooksMessage(currentHook, previousHook string) rule.RuleMessage
 var PreferHooksInOrderRule = rule.Rule{
 	Name:   "jest/prefer-hooks-in-order",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"reorderHooks"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		previousHookIndex := -1
 		inHook := false
@@ -16,6 +16,10 @@ func buildNoHookOnTopMessage() rule.RuleMessage {
 var PreferHooksOnTopRule = rule.Rule{
 	Name:   "jest/prefer-hooks-on-top",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"noHookOnTop"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		hooksContext := []bool{false}
 
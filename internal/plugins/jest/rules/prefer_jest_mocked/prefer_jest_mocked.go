@@ -71,6 +71,11 @@ func checkJestMockAssertion(ctx rule.RuleContext, node *ast.Node, typeNode, expr
 var PreferJestMockedRule = rule.Rule{
 	Name:   "jest/prefer-jest-mocked",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"useJestMocked"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindAsExpression: func(node *ast.Node) {
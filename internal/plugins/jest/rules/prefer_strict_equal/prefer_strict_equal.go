@@ -26,6 +26,11 @@ func buildSuggestReplaceWithStrictEqualErrorMessage() rule.RuleMessage {
 var PreferStrictEqualRule = rule.Rule{
 	Name:   "jest/prefer-strict-equal",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:           rule.RuleTypeSuggestion,
+		HasSuggestions: true,
+		MessageIds:     []string{"useToStrictEqual", "suggestReplaceWithStrictEqual"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindCallExpression: func(node *ast.Node) {
@@ -211,6 +211,11 @@ func reportPreferToBe(ctx rule.RuleContext, kind preferKind, jestFnCall *utils.P
 var PreferToBeRule = rule.Rule{
 	Name:   "jest/prefer-to-be",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"useToBe", "useToBeUndefined", "useToBeDefined", "useToBeNull", "useToBeNaN"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindCallExpression: func(node *ast.Node) {
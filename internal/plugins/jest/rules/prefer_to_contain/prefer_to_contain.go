@@ -50,6 +50,11 @@ func getIncludesCalleeName(callee *ast.Node) (receiver *ast.Node, ok bool) {
 var PreferToContainRule = rule.Rule{
 	Name:   "jest/prefer-to-contain",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"useToContain"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindCallExpression: func(node *ast.Node) {
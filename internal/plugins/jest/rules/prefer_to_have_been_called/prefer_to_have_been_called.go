@@ -27,6 +27,11 @@ func isZeroLiteral(node *ast.Node) bool {
 var PreferToHaveBeenCalledRule = rule.Rule{
 	Name:   "jest/prefer-to-have-been-called",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"preferMatcher"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindCallExpression: func(node *ast.Node) {
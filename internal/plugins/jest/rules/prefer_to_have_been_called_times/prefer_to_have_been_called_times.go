@@ -77,6 +77,11 @@ func unwrapMockCallsAccessProperty(arg *ast.Node) *ast.Node {
 var PreferToHaveBeenCalledTimesRule = rule.Rule{
 	Name:   "jest/prefer-to-have-been-called-times",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"preferMatcher"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindCallExpression: func(node *ast.Node) {
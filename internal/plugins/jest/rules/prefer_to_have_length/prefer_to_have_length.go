@@ -58,6 +58,11 @@ func unwrapLengthAccessProperty(arg *ast.Node) *ast.Node {
 var PreferToHaveLengthRule = rule.Rule{
 	Name:   "jest/prefer-to-have-length",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"useToHaveLength"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindCallExpression: func(node *ast.Node) {
@@ -128,6 +128,11 @@ func buildTodoCallReplacementUnimplemented(ctx rule.RuleContext, callExpr *ast.C
 var PreferTodoRule = rule.Rule{
 	Name:   "jest/prefer-todo",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"emptyTest", "unimplementedTest"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindCallExpression: func(node *ast.Node) {
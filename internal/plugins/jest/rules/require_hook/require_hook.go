@@ -185,6 +185,10 @@ func checkBlockBody(ctx rule.RuleContext, body []*ast.Node, allowedFunctionCalls
 var RequireHookRule = rule.Rule{
 	Name:   "jest/require-hook",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"useHook"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 
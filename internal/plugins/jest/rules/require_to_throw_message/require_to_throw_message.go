@@ -26,6 +26,10 @@ func buildAddErrorMessage(matcherName string) rule.RuleMessage {
 var RequireToThrowMessageRule = rule.Rule{
 	Name:   "jest/require-to-throw-message",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"addErrorMessage"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindCallExpression: func(node *ast.Node) {
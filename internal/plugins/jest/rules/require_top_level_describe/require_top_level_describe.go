@@ -62,6 +62,10 @@ var (
 var RequireTopLevelDescribeRule = rule.Rule{
 	Name:   "jest/require-top-level-describe",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"tooManyDescribes", "unexpectedTestCase", "unexpectedHook"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 		numberOfDescribeBlocks := 0
@@ -56,6 +56,10 @@ func findFirstReturnStatement(nodes []*ast.Node) *ast.Node {
 var ValidDescribeCallbackRule = rule.Rule{
 	Name:   "jest/valid-describe-callback",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"nameAndCallback", "secondArgumentMustBeFunction", "noAsyncDescribeCallback", "unexpectedDescribeArgument", "unexpectedReturnInDescribe"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindCallExpression: func(node *ast.Node) {
@@ -297,6 +297,11 @@ func findTopLevelMemberAccess(node *ast.Node) *ast.Node {
 var ValidExpectRule = rule.Rule{
 	Name:   "jest/valid-expect",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"tooManyArgs", "notEnoughArgs", "modifierUnknown", "matcherNotFound", "matcherNotCalled", "asyncMustBeAwaited", "promisesWithAsyncAssertionsMustBeAwaited"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 		arrayExceptions := map[string]bool{}
@@ -88,6 +88,10 @@ func parseOptions(raw []any) options {
 var AltTextRule = rule.Rule{
 	Name:   "jsx-a11y/alt-text",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"preferAlt", "ariaLabelValue", "ariaLabelledByValue", "missingProp", "altValue", "object", "area", "inputImage"},
+	},
 	Run: func(ctx rule.RuleContext, rawOptions []any) rule.RuleListeners {
 		opts := parseOptions(rawOptions)
 
@@ -269,6 +269,10 @@ func parseOptions(raw []any) options {
 var AnchorAmbiguousTextRule = rule.Rule{
 	Name:   "jsx-a11y/anchor-ambiguous-text",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"anchorAmbiguousText"},
+	},
 	Run: func(ctx rule.RuleContext, rawOptions []any) rule.RuleListeners {
 		opts := parseOptions(rawOptions)
 
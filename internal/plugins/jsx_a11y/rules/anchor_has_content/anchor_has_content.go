@@ -41,6 +41,10 @@ func parseOptions(raw []any) options {
 var AnchorHasContentRule = rule.Rule{
 	Name:   "jsx-a11y/anchor-has-content",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"anchorHasContent"},
+	},
 	Run: func(ctx rule.RuleContext, rawOptions []any) rule.RuleListeners {
 		opts := parseOptions(rawOptions)
 
@@ -127,6 +127,10 @@ func parseOptions(raw []any) options {
 var AnchorIsValidRule = rule.Rule{
 	Name:   "jsx-a11y/anchor-is-valid",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"noHref", "preferButton", "invalidHref"},
+	},
 	Run: func(ctx rule.RuleContext, rawOptions []any) rule.RuleListeners {
 		opts := parseOptions(rawOptions)
 		// typeCheck = ['a'].concat(componentOptions). Resolved against the
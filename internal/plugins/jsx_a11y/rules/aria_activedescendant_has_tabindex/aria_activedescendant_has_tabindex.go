@@ -38,6 +38,10 @@ const errorMessage = "An element that manages focus with `aria-activedescendant`
 var AriaActivedescendantHasTabindexRule = rule.Rule{
 	Name:   "jsx-a11y/aria-activedescendant-has-tabindex",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"tabIndexRequired"},
+	},
 	Run: func(ctx rule.RuleContext, _ []any) rule.RuleListeners {
 		// sourceText is required by GetTabIndexEx for raw-text template
 		// literal extraction (NoSubstitutionTemplate has no RawText field).
@@ -58,6 +58,10 @@ func errorMessage(name string) string {
 var AriaPropsRule = rule.Rule{
 	Name:   "jsx-a11y/aria-props",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"invalidAriaProp"},
+	},
 	Run: func(ctx rule.RuleContext, _ []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindJsxAttribute: func(attr *ast.Node) {
@@ -214,6 +214,10 @@ func permittedValuesContainsString(values []any, candidate string) bool {
 var AriaProptypesRule = rule.Rule{
 	Name:   "jsx-a11y/aria-proptypes",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"invalidAriaPropType"},
+	},
 	Run: func(ctx rule.RuleContext, _ []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindJsxAttribute: func(attr *ast.Node) {
@@ -102,6 +102,10 @@ func reportIfInvalid(ctx rule.RuleContext, attr *ast.Node, str string, allowed m
 var AriaRoleRule = rule.Rule{
 	Name:   "jsx-a11y/aria-role",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"invalidAriaRole"},
+	},
 	Run: func(ctx rule.RuleContext, rawOptions []any) rule.RuleListeners {
 		opts := parseOptions(rawOptions)
 		return rule.RuleListeners{
@@ -52,6 +52,10 @@ func errorMessage(invalidProp string) string {
 var AriaUnsupportedElementsRule = rule.Rule{
 	Name:   "jsx-a11y/aria-unsupported-elements",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"unsupported"},
+	},
 	Run: func(ctx rule.RuleContext, _ []any) rule.RuleListeners {
 		check := func(node *ast.Node) {
 			nodeType := jsxa11yutil.GetElementType(node, ctx.Settings)
@@ -181,6 +181,10 @@ func parseOptions(raw []any) options {
 var AutocompleteValidRule = rule.Rule{
 	Name:   "jsx-a11y/autocomplete-valid",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"autocompleteValid"},
+	},
 	Run: func(ctx rule.RuleContext, rawOptions []any) rule.RuleListeners {
 		opts := parseOptions(rawOptions)
 
@@ -40,6 +40,10 @@ const errorMessage = "Visible, non-interactive elements with click handlers must
 var ClickEventsHaveKeyEventsRule = rule.Rule{
 	Name:   "jsx-a11y/click-events-have-key-events",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"clickEventsHaveKeyEvents"},
+	},
 	Run: func(ctx rule.RuleContext, _ []any) rule.RuleListeners {
 		getElementType := func(node *ast.Node) string {
 			return jsxa11yutil.GetElementType(node, ctx.Settings)
@@ -83,6 +83,10 @@ func parseOptions(raw []any) options {
 var ControlHasAssociatedLabelRule = rule.Rule{
 	Name:   "jsx-a11y/control-has-associated-label",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"controlHasAssociatedLabel"},
+	},
 	Run: func(ctx rule.RuleContext, rawOptions []any) rule.RuleListeners {
 		opts := parseOptions(rawOptions)
 		// Upstream `newIgnoreElements = new Set([].concat(ignoreElements,
@@ -45,6 +45,10 @@ func parseOptions(raw []any) options {
 var HeadingHasContentRule = rule.Rule{
 	Name:   "jsx-a11y/heading-has-content",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"headingHasContent"},
+	},
 	Run: func(ctx rule.RuleContext, rawOptions []any) rule.RuleListeners {
 		opts := parseOptions(rawOptions)
 
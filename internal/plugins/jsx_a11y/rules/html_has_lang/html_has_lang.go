@@ -15,6 +15,10 @@ const errorMessage = "<html> elements must have the lang prop."
 var HtmlHasLangRule = rule.Rule{
 	Name:   "jsx-a11y/html-has-lang",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"htmlHasLang"},
+	},
 	Run: func(ctx rule.RuleContext, _ []any) rule.RuleListeners {
 		elementType := func(node *ast.Node) string {
 			return jsxa11yutil.GetElementType(node, ctx.Settings)
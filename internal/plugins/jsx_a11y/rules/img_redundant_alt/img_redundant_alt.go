@@ -41,6 +41,10 @@ func parseOptions(raw []any) options {
 var ImgRedundantAltRule = rule.Rule{
 	Name:   "jsx-a11y/img-redundant-alt",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"redundantAlt"},
+	},
 	Run: func(ctx rule.RuleContext, rawOptions []any) rule.RuleListeners {
 		opts := parseOptions(rawOptions)
 
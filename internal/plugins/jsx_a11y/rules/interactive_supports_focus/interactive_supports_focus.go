@@ -84,6 +84,11 @@ func parseOptions(raw []any) options {
 var InteractiveSupportsFocusRule = rule.Rule{
 	Name:   "jsx-a11y/interactive-supports-focus",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:           rule.RuleTypeSuggestion,
+		HasSuggestions: true,
+		MessageIds:     []string{"tabIndexZero", "tabbable", "tabIndexNegOne", "focusable"},
+	},
 	Run: func(ctx rule.RuleContext, rawOptions []any) rule.RuleListeners {
 		opts := parseOptions(rawOptions)
 		sourceText := ctx.SourceFile.Text()
@@ -161,6 +161,9 @@ func parseOptions(raw []any) options {
 var LabelHasAssociatedControlRule = rule.Rule{
 	Name:   "jsx-a11y/label-has-associated-control",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type: rule.RuleTypeSuggestion,
+	},
 	Run: func(ctx rule.RuleContext, rawOptions []any) rule.RuleListeners {
 		opts := parseOptions(rawOptions)
 
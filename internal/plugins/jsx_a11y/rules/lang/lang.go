@@ -18,6 +18,10 @@ const errorMessage = "lang attribute must have a valid value."
 var LangRule = rule.Rule{
 	Name:   "jsx-a11y/lang",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"invalidLangValue"},
+	},
 	Run: func(ctx rule.RuleContext, _ []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindJsxAttribute: func(attr *ast.Node) {
@@ -55,6 +55,10 @@ func parseOptions(raw []any) options {
 var MediaHasCaptionRule = rule.Rule{
 	Name:   "jsx-a11y/media-has-caption",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"mediaHasCaption"},
+	},
 	Run: func(ctx rule.RuleContext, rawOptions []any) rule.RuleListeners {
 		opts := parseOptions(rawOptions)
 
@@ -145,6 +145,10 @@ func pairAttributeIsMissing(attrs []*ast.Node, pairName string) bool {
 var MouseEventsHaveKeyEventsRule = rule.Rule{
 	Name:   "jsx-a11y/mouse-events-have-key-events",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"mouseOver", "mouseOut"},
+	},
 	Run: func(ctx rule.RuleContext, rawOptions []any) rule.RuleListeners {
 		opts := parseOptions(rawOptions)
 
@@ -24,6 +24,10 @@ const errorMessage = "No access key attribute allowed. Inconsistencies between k
 var NoAccessKeyRule = rule.Rule{
 	Name:   "jsx-a11y/no-access-key",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"noAccessKey"},
+	},
 	Run: func(ctx rule.RuleContext, _ []any) rule.RuleListeners {
 		check := func(node *ast.Node) {
 			attrs := reactutil.GetJsxElementAttributes(node)
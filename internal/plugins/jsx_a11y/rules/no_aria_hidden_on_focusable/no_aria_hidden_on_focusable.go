@@ -46,6 +46,10 @@ const errorMessage = `aria-hidden="true" must not be set on focusable elements.`
 var NoAriaHiddenOnFocusableRule = rule.Rule{
 	Name:   "jsx-a11y/no-aria-hidden-on-focusable",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"noAriaHiddenOnFocusable"},
+	},
 	Run: func(ctx rule.RuleContext, _ []any) rule.RuleListeners {
 		sourceText := ctx.SourceFile.Text()
 
@@ -61,6 +61,10 @@ func parseOptions(raw []any) options {
 var NoAutofocusRule = rule.Rule{
 	Name:   "jsx-a11y/no-autofocus",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"noAutoFocus"},
+	},
 	Run: func(ctx rule.RuleContext, rawOptions []any) rule.RuleListeners {
 		opts := parseOptions(rawOptions)
 		return rule.RuleListeners{
@@ -64,6 +64,10 @@ func parseOptions(raw []any) options {
 var NoDistractingElementsRule = rule.Rule{
 	Name:   "jsx-a11y/no-distracting-elements",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"distractingElement"},
+	},
 	Run: func(ctx rule.RuleContext, rawOptions []any) rule.RuleListeners {
 		opts := parseOptions(rawOptions)
 
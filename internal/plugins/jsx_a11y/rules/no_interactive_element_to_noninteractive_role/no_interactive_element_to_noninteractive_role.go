@@ -95,6 +95,10 @@ func parseOptions(raw []any) options {
 var NoInteractiveElementToNoninteractiveRoleRule = rule.Rule{
 	Name:   "jsx-a11y/no-interactive-element-to-noninteractive-role",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"noInteractiveElementToNoninteractiveRole"},
+	},
 	Run: func(ctx rule.RuleContext, rawOptions []any) rule.RuleListeners {
 		opts := parseOptions(rawOptions)
 
@@ -128,6 +128,10 @@ func parseOptions(raw []any) options {
 var NoNoninteractiveElementInteractionsRule = rule.Rule{
 	Name:   "jsx-a11y/no-noninteractive-element-interactions",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"noNoninteractiveElementInteractions"},
+	},
 	Run: func(ctx rule.RuleContext, rawOptions []any) rule.RuleListeners {
 		opts := parseOptions(rawOptions)
 		interactiveProps := defaultInteractiveProps
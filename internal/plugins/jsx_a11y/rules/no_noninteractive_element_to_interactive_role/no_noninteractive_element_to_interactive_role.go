@@ -101,6 +101,10 @@ func parseOptions(raw []any) options {
 var NoNoninteractiveElementToInteractiveRoleRule = rule.Rule{
 	Name:   "jsx-a11y/no-noninteractive-element-to-interactive-role",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"noNoninteractiveElementToInteractiveRole"},
+	},
 	Run: func(ctx rule.RuleContext, rawOptions []any) rule.RuleListeners {
 		opts := parseOptions(rawOptions)
 
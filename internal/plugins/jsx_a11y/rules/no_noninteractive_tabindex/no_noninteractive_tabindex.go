@@ -73,6 +73,10 @@ func parseOptions(raw []any) options {
 var NoNoninteractiveTabindexRule = rule.Rule{
 	Name:   "jsx-a11y/no-noninteractive-tabindex",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"noNoninteractiveTabindex"},
+	},
 	Run: func(ctx rule.RuleContext, rawOptions []any) rule.RuleListeners {
 		opts := parseOptions(rawOptions)
 		// sourceText is required by GetTabIndexEx for raw-text template
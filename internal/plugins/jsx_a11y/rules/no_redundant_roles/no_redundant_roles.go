@@ -100,6 +100,10 @@ func parseOptions(raw []any) map[string][]string {
 var NoRedundantRolesRule = rule.Rule{
 	Name:   "jsx-a11y/no-redundant-roles",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"noRedundantRoles"},
+	},
 	Run: func(ctx rule.RuleContext, rawOptions []any) rule.RuleListeners {
 		opts := parseOptions(rawOptions)
 
@@ -132,6 +132,10 @@ func hasNonNullInteractiveHandler(attrs []*ast.Node, handlers []string) bool {
 var NoStaticElementInteractionsRule = rule.Rule{
 	Name:   "jsx-a11y/no-static-element-interactions",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"noStaticElementInteractions"},
+	},
 	Run: func(ctx rule.RuleContext, rawOptions []any) rule.RuleListeners {
 		opts := parseOptions(rawOptions)
 		handlers := opts.Handlers
@@ -168,6 +168,10 @@ func lastSpaceSeparatedToken(s string) string {
 var PreferTagOverRoleRule = rule.Rule{
 	Name:   "jsx-a11y/prefer-tag-over-role",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"preferTagOverRole"},
+	},
 	Run: func(ctx rule.RuleContext, _ []any) rule.RuleListeners {
 		check := func(node *ast.Node) {
 			attrs := reactutil.GetJsxElementAttributes(node)
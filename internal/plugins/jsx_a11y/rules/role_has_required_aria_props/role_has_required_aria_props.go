@@ -175,6 +175,10 @@ func isSemanticRoleElement(elementType string, attrs []*ast.Node, roleAttrValue
 var RoleHasRequiredAriaPropsRule = rule.Rule{
 	Name:   "jsx-a11y/role-has-required-aria-props",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"role-has-required-aria-props"},
+	},
 	Run: func(ctx rule.RuleContext, _ []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindJsxAttribute: func(attr *ast.Node) {
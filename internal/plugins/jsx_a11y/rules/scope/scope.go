@@ -46,6 +46,10 @@ const errorMessage = "The scope prop can only be used on <th> elements."
 var ScopeRule = rule.Rule{
 	Name:   "jsx-a11y/scope",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"scopeOnTh"},
+	},
 	Run: func(ctx rule.RuleContext, _ []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindJsxAttribute: func(attr *ast.Node) {
@@ -51,6 +51,10 @@ const errorMessage = "Avoid positive integer values for tabIndex."
 var TabindexNoPositiveRule = rule.Rule{
 	Name:   "jsx-a11y/tabindex-no-positive",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"tabIndexNoPositive"},
+	},
 	Run: func(ctx rule.RuleContext, _ []any) rule.RuleListeners {
 		// Source text is needed for raw-string extraction on template
 		// literals (NoSubstitutionTemplateLiteral has no RawText field in
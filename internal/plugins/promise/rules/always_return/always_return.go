@@ -184,6 +184,10 @@ func rootObjectName(node *ast.Node) string {
 var AlwaysReturnRule = rule.Rule{
 	Name:   "promise/always-return",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"thenShouldReturnOrThrow"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 		return rule.RuleListeners{
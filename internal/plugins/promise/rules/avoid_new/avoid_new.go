@@ -15,6 +15,10 @@ const skipTransparent = ast.OEKParentheses
 var AvoidNewRule = rule.Rule{
 	Name:   "promise/avoid-new",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"avoidNew"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindNewExpression: func(node *ast.Node) {
@@ -101,6 +101,10 @@ func isAllowedPromiseTermination(expression *ast.Node, opts Options) bool {
 var CatchOrReturnRule = rule.Rule{
 	Name:   "promise/catch-or-return",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"terminationMethod"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 		return rule.RuleListeners{
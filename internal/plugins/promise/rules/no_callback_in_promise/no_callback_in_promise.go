@@ -142,6 +142,10 @@ func ancestorSome(node *ast.Node, pred func(*ast.Node) bool) bool {
 var NoCallbackInPromiseRule = rule.Rule{
 	Name:   "promise/no-callback-in-promise",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"callback"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 		return rule.RuleListeners{
@@ -713,6 +713,9 @@ func isPromiseExecutorFunction(node *ast.Node) *ast.Node {
 var NoMultipleResolvedRule = rule.Rule{
 	Name:   "promise/no-multiple-resolved",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type: rule.RuleTypeProblem,
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		reported := make(map[*ast.Node]bool)
 
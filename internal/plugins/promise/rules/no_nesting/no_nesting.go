@@ -125,6 +125,10 @@ func buildAvoidNestingMessage() rule.RuleMessage {
 var NoNestingRule = rule.Rule{
 	Name:   "promise/no-nesting",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"avoidNesting"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		// Stack of promise-callback function nodes, closest last.
 		callbackStack := []*ast.Node{}
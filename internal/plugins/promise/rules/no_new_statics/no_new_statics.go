@@ -36,6 +36,11 @@ func buildMessage(name string) rule.RuleMessage {
 var NoNewStaticsRule = rule.Rule{
 	Name:   "promise/no-new-statics",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"avoidNewStatic"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindNewExpression: func(node *ast.Node) {
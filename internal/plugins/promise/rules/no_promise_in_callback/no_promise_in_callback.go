@@ -129,6 +129,10 @@ func findCallbackAncestor(node *ast.Node, opts Options) *ast.Node {
 var NoPromiseInCallbackRule = rule.Rule{
 	Name:   "promise/no-promise-in-callback",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"avoidPromiseInCallback"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 		return rule.RuleListeners{
@@ -33,6 +33,10 @@ func callbackBody(fn *ast.Node) *ast.Node {
 var NoReturnInFinallyRule = rule.Rule{
 	Name:   "promise/no-return-in-finally",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"noReturnInFinally"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindCallExpression: func(node *ast.Node) {
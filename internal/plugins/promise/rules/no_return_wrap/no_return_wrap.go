@@ -147,6 +147,10 @@ func isExpressionBodyArrowCall(node *ast.Node) bool {
 var NoReturnWrapRule = rule.Rule{
 	Name:   "promise/no-return-wrap",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"resolve", "reject"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 		return rule.RuleListeners{
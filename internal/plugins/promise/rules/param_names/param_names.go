@@ -89,6 +89,10 @@ func paramName(param *ast.Node) string {
 var ParamNamesRule = rule.Rule{
 	Name:   "promise/param-names",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"resolveParamNames", "rejectParamNames"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 		// ECMAScript + Unicode flags mirror ESLint's `new RegExp(pattern, 'u')`
@@ -126,6 +126,10 @@ func isCypress(node *ast.Node) bool {
 var PreferAwaitToThenRule = rule.Rule{
 	Name:   "promise/prefer-await-to-then",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"preferAwaitToCallback"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 		return rule.RuleListeners{
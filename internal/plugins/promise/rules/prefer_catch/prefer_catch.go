@@ -20,6 +20,11 @@ func buildPreferCatchToThenMessage() rule.RuleMessage {
 var PreferCatchRule = rule.Rule{
 	Name:   "promise/prefer-catch",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"preferCatchToThen"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindCallExpression: func(node *ast.Node) {
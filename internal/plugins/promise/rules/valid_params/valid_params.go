@@ -90,6 +90,10 @@ func calledMemberName(node *ast.Node) string {
 var ValidParamsRule = rule.Rule{
 	Name:   "promise/valid-params",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"requireOneOptionalArgument", "requireOneArgument", "requireTwoOptionalArguments"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 		return rule.RuleListeners{
@@ -64,6 +64,11 @@ func MakeNoMethodSetStateRule(cfg NoMethodSetStateConfig) rule.Rule {
 	return rule.Rule{
 		Name:   cfg.RuleName,
 		Schema: sharedSchema,
+		Meta: rule.RuleMeta{
+			Type:       rule.RuleTypeSuggestion,
+			Fixable:    rule.FixableCode,
+			MessageIds: []string{"wrongIndent", "noSetState"},
+		},
 		Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 			if cfg.ShouldBeNoop != nil && cfg.ShouldBeNoop(ctx.Settings) {
 				return rule.RuleListeners{}
@@ -18,7 +18,11 @@ var schemaJSON []byte
 var BooleanPropNamingRule = rule.Rule{
 	Name:   "react/boolean-prop-naming",
 	Schema: rule.NewSchema(schemaJSON),
-	Run:    runRule,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"patternMismatch"},
+	},
+	Run: runRule,
 }
 
 type ruleOptions struct {
@@ -39,6 +39,10 @@ func parseOptions(opts []any) buttonHasTypeOptions {
 var ButtonHasTypeRule = rule.Rule{
 	Name:   "react/button-has-type",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"missingType", "complexType", "invalidValue", "forbiddenValue"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		cfg := parseOptions(options)
 
@@ -47,6 +47,10 @@ func parseOptions(opts []any) options {
 var CheckedRequiresOnchangeOrReadonlyRule = rule.Rule{
 	Name:   "react/checked-requires-onchange-or-readonly",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"exclusiveCheckedAttribute", "missingProperty"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		o := parseOptions(options)
 		pragma := reactutil.GetReactPragma(ctx.Settings)
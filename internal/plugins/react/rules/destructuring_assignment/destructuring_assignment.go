@@ -454,6 +454,11 @@ func parseOptions(options []any) ruleOptions {
 var DestructuringAssignmentRule = rule.Rule{
 	Name:   "react/destructuring-assignment",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"useDestructAssignment", "noDestructAssignment", "noDestructPropsInSFCArg", "noDestructContextInSFCArg", "destructureInSignature"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 		pragma := reactutil.GetReactPragma(ctx.Settings)
@@ -1523,6 +1523,10 @@ func (w *nodeWalker) isNestedMemo(node *ast.Node) bool {
 var DisplayNameRule = rule.Rule{
 	Name:   "react/display-name",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"noDisplayName", "noContextDisplayName"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 		pragma := reactutil.GetReactPragma(ctx.Settings)
@@ -287,6 +287,10 @@ func getTagShape(tagName *ast.Node) (tagShape, bool) {
 var ForbidComponentPropsRule = rule.Rule{
 	Name:   "react/forbid-component-props",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"propIsForbidden"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		cfg := parseOptions(options)
 		return rule.RuleListeners{
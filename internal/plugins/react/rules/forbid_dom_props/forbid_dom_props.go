@@ -161,6 +161,10 @@ func stringLiteralValue(attr *ast.Node) (string, bool) {
 var ForbidDomPropsRule = rule.Rule{
 	Name:   "react/forbid-dom-props",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"propIsForbiddenWithValue", "propIsForbidden"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		cfg := parseOptions(options)
 		// Empty config short-circuits the whole listener — matches the
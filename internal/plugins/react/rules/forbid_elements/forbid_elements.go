@@ -158,6 +158,10 @@ func isCreateElementCall(callee *ast.Node, pragma string, tc *checker.Checker) b
 var ForbidElementsRule = rule.Rule{
 	Name:   "react/forbid-elements",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"forbiddenElement_message", "forbiddenElement"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		indexed := parseOptions(options)
 		if len(indexed) == 0 {
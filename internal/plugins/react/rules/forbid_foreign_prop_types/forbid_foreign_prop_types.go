@@ -182,6 +182,10 @@ func findPropTypesKey(elements []*ast.Node) *ast.Node {
 var ForbidForeignPropTypesRule = rule.Rule{
 	Name:   "react/forbid-foreign-prop-types",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"forbiddenPropType"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 		msg := rule.RuleMessage{
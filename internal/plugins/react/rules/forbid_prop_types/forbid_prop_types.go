@@ -70,6 +70,10 @@ func getPropertyAccessName(node *ast.Node) string {
 var ForbidPropTypesRule = rule.Rule{
 	Name:   "react/forbid-prop-types",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"forbiddenPropType"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 		propWrappers := reactutil.GetPropWrapperFunctions(ctx.Settings)
@@ -99,6 +99,11 @@ func removeForwardRefSuggestion(ctx rule.RuleContext, call *ast.Node, fn *ast.No
 var ForwardRefUsesRefRule = rule.Rule{
 	Name:   "react/forward-ref-uses-ref",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:           rule.RuleTypeSuggestion,
+		HasSuggestions: true,
+		MessageIds:     []string{"missingRefParameter", "addRefParameter", "removeForwardRef"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		checkFunction := func(node *ast.Node) {
 			call := parentForwardRefCall(node)
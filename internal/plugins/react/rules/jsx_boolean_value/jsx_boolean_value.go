@@ -15,6 +15,11 @@ var schemaJSON []byte
 var JsxBooleanValueRule = rule.Rule{
 	Name:   "react/jsx-boolean-value",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"omitBoolean", "omitPropAndBoolean", "setBoolean"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		mode := "never" // default mode
 		var exceptions map[string]bool
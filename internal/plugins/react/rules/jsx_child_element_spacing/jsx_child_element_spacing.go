@@ -112,6 +112,10 @@ func handleJSX(ctx rule.RuleContext, node *ast.Node) {
 var JsxChildElementSpacingRule = rule.Rule{
 	Name:   "react/jsx-child-element-spacing",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeLayout,
+		MessageIds: []string{"spacingAfterPrev", "spacingBeforeNext"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindJsxElement:  func(node *ast.Node) { handleJSX(ctx, node) },
@@ -111,6 +111,11 @@ func leadingWhitespace(text string, lineStart int) string {
 var JsxClosingBracketLocationRule = rule.Rule{
 	Name:   "react/jsx-closing-bracket-location",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeLayout,
+		Fixable:    rule.FixableWhitespace,
+		MessageIds: []string{"bracketLocation"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 
@@ -18,6 +18,11 @@ var schemaJSON []byte
 var JsxClosingTagLocationRule = rule.Rule{
 	Name:   "react/jsx-closing-tag-location",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeLayout,
+		Fixable:    rule.FixableWhitespace,
+		MessageIds: []string{"onOwnLine"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		// Default: "tag-aligned"
 		location := "tag-aligned"
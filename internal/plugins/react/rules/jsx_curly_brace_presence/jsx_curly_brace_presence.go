@@ -424,7 +424,12 @@ func BuildRule(name string) rule.Rule {
 	return rule.Rule{
 		Name:   name,
 		Schema: rule.NewSchema(schemaJSON),
-		Run:    makeRun(),
+		Meta: rule.RuleMeta{
+			Type:       rule.RuleTypeSuggestion,
+			Fixable:    rule.FixableCode,
+			MessageIds: []string{"unnecessaryCurly", "missingCurly"},
+		},
+		Run: makeRun(),
 	}
 }
 
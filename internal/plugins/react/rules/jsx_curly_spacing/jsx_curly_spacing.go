@@ -369,6 +369,11 @@ func BuildRule(name string) rule.Rule {
 	return rule.Rule{
 		Name:   name,
 		Schema: rule.NewSchema(schemaJSON),
+		Meta: rule.RuleMeta{
+			Type:       rule.RuleTypeLayout,
+			Fixable:    rule.FixableWhitespace,
+			MessageIds: []string{"noSpaceAfter", "noSpaceBefore", "noNewlineAfter", "noNewlineBefore", "spaceNeededAfter", "spaceNeededBefore"},
+		},
 		Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 			attrsConfig, childrenConfig := parseOptions(options)
 
@@ -126,6 +126,11 @@ func BuildRule(name string) rule.Rule {
 	return rule.Rule{
 		Name:   name,
 		Schema: rule.NewSchema(schemaJSON),
+		Meta: rule.RuleMeta{
+			Type:       rule.RuleTypeLayout,
+			Fixable:    rule.FixableWhitespace,
+			MessageIds: []string{"noSpaceBefore", "noSpaceAfter", "needSpaceBefore", "needSpaceAfter"},
+		},
 		Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 			config := parseOption(options)
 			sf := ctx.SourceFile
@@ -18,6 +18,10 @@ var schemaJSON []byte
 var JsxFilenameExtensionRule = rule.Rule{
 	Name:   "react/jsx-filename-extension",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"extensionOnlyForJSX", "noJSXWithExtension"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		// Default options
 		extensions := []string{".jsx"}
@@ -38,6 +38,11 @@ func BuildRule(name string) rule.Rule {
 	return rule.Rule{
 		Name:   name,
 		Schema: rule.NewSchema(schemaJSON),
+		Meta: rule.RuleMeta{
+			Type:       rule.RuleTypeLayout,
+			Fixable:    rule.FixableWhitespace,
+			MessageIds: []string{"propOnNewLine", "propOnSameLine"},
+		},
 		Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 			// Default option
 			option := "multiline-multiprop"
@@ -20,6 +20,11 @@ const (
 var JsxFragmentsRule = rule.Rule{
 	Name:   "react/jsx-fragments",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"fragmentsNotSupported", "preferPragma", "preferFragment"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		mode := parseMode(options)
 		reactPragma := reactutil.GetReactPragma(ctx.Settings)
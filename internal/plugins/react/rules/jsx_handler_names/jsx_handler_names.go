@@ -205,6 +205,10 @@ func isPlainMemberAccess(node *ast.Node) bool {
 var JsxHandlerNamesRule = rule.Rule{
 	Name:   "react/jsx-handler-names",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"badHandlerName", "badPropKey"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 
@@ -35,6 +35,10 @@ var schemaJSON []byte
 var JsxIndentRule = rule.Rule{
 	Name:   "react/jsx-indent",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:    rule.RuleTypeLayout,
+		Fixable: rule.FixableWhitespace,
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		indentType, indentSize, indentChar := parseIndentOption(options)
 		checkAttributes, indentLogicalExpressions := parseSecondOption(options)
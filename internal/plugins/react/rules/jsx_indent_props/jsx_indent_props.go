@@ -45,6 +45,9 @@ func BuildRule(name string) rule.Rule {
 	return rule.Rule{
 		Name:   name,
 		Schema: rule.NewSchema(schemaJSON),
+		Meta: rule.RuleMeta{
+			Type: rule.RuleTypeLayout,
+		},
 		Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 			indentType, indentSize, indentChar, indentIsFirst, ignoreTernaryOperator := parseOptions(options)
 
@@ -33,6 +33,10 @@ func parseOptions(raw []any) options {
 var JsxKeyRule = rule.Rule{
 	Name:   "react/jsx-key",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"missingIterKey", "missingIterKeyUsePrag", "missingArrayKey", "missingArrayKeyUsePrag", "keyBeforeSpread", "nonUniqueKeys"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 		reactPragma := reactutil.GetReactPragma(ctx.Settings)
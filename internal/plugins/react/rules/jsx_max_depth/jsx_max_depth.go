@@ -1012,6 +1012,10 @@ func checkDescendant(ctx rule.RuleContext, baseDepth, maxDepth int, children []*
 var JsxMaxDepthRule = rule.Rule{
 	Name:   "react/jsx-max-depth",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"wrongDepth"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 
@@ -19,6 +19,11 @@ var schemaJSON []byte
 var JsxMaxPropsPerLineRule = rule.Rule{
 	Name:   "react/jsx-max-props-per-line",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeLayout,
+		Fixable:    rule.FixableWhitespace,
+		MessageIds: []string{"newLine"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		// Defaults
 		singleLimit := 0 // 0 means "not explicitly set"
@@ -72,6 +72,9 @@ func message(id string) rule.RuleMessage {
 var JsxNoBindRule = rule.Rule{
 	Name:   "react/jsx-no-bind",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type: rule.RuleTypeSuggestion,
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 
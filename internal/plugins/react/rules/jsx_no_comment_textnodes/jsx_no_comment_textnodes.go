@@ -70,6 +70,10 @@ func isJsWhitespace(r rune) bool {
 var JsxNoCommentTextnodesRule = rule.Rule{
 	Name:   "react/jsx-no-comment-textnodes",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"putCommentInBraces"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			// In tsgo, comment-like source (e.g. `<div>// foo</div>`) is parsed
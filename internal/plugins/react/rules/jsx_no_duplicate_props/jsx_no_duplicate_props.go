@@ -15,6 +15,10 @@ var schemaJSON []byte
 var JsxNoDuplicatePropsRule = rule.Rule{
 	Name:   "react/jsx-no-duplicate-props",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"noDuplicateProps"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		ignoreCase := false
 		if len(options) > 0 {
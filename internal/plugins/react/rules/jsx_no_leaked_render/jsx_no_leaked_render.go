@@ -77,6 +77,11 @@ func hasStrategy(list []string, s string) bool {
 var JsxNoLeakedRenderRule = rule.Rule{
 	Name:   "react/jsx-no-leaked-render",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"noPotentialLeakedRender"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 		var fixStrategy string
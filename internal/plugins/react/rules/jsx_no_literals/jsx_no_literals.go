@@ -753,6 +753,9 @@ func handleTemplate(ctx rule.RuleContext, node *ast.Node, cfg *ruleConfig, renam
 var JsxNoLiteralsRule = rule.Rule{
 	Name:   "react/jsx-no-literals",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type: rule.RuleTypeSuggestion,
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		cfg := parseConfig(options)
 		var renamedImports map[string]string
@@ -27,6 +27,10 @@ const noScriptURLMessage = "A future version of React will block javascript: URL
 var JsxNoScriptUrlRule = rule.Rule{
 	Name:   "react/jsx-no-script-url",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"noScriptURL"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		config := parseConfig(options, ctx.Settings)
 
@@ -413,6 +413,10 @@ func reportWithOptionalFix(ctx rule.RuleContext, node *ast.Node, messageId, desc
 var JsxNoTargetBlankRule = rule.Rule{
 	Name:   "react/jsx-no-target-blank",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:    rule.RuleTypeProblem,
+		Fixable: rule.FixableCode,
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 		linkComponents := reactutil.ReadComponentsFromSettings(ctx.Settings, "linkComponents", "linkAttribute", "href", reactutil.DefaultLinkComponents())
@@ -17,6 +17,10 @@ var schemaJSON []byte
 var JsxNoUndefRule = rule.Rule{
 	Name:   "react/jsx-no-undef",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"undefined"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		allowGlobals := false
 		if len(options) > 0 {
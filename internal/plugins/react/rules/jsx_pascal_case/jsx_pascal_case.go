@@ -164,6 +164,9 @@ func ignoreCheck(patterns []compiledPattern, name string) bool {
 var JsxPascalCaseRule = rule.Rule{
 	Name:   "react/jsx-pascal-case",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type: rule.RuleTypeSuggestion,
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		cfg := parseOptions(options)
 
@@ -15,6 +15,11 @@ import (
 var JsxPropsNoMultiSpacesRule = rule.Rule{
 	Name:   "react/jsx-props-no-multi-spaces",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeLayout,
+		Fixable:    rule.FixableWhitespace,
+		MessageIds: []string{"onlyOneSpace", "noLineGap"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		text := ctx.SourceFile.Text()
 		lineStarts := ctx.SourceFile.ECMALineMap()
@@ -11,6 +11,10 @@ import (
 var JsxPropsNoSpreadMultiRule = rule.Rule{
 	Name:   "react/jsx-props-no-spread-multi",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"noMultiSpreading"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		check := func(node *ast.Node) {
 			attrs := reactutil.GetJsxElementAttributes(node)
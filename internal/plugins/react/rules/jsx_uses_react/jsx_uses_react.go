@@ -21,6 +21,9 @@ import (
 var JsxUsesReactRule = rule.Rule{
 	Name:   "react/jsx-uses-react",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type: rule.RuleTypeProblem,
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{}
 	},
@@ -14,6 +14,9 @@ import (
 var JsxUsesVarsRule = rule.Rule{
 	Name:   "react/jsx-uses-vars",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type: rule.RuleTypeProblem,
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{}
 	},
@@ -29,6 +29,11 @@ var defaultOptions = map[string]string{
 var JsxWrapMultilinesRule = rule.Rule{
 	Name:   "react/jsx-wrap-multilines",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeLayout,
+		Fixable:    rule.FixableWhitespace,
+		MessageIds: []string{"missingParens", "parensOnNewLines", "extraParens"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		// Build effective options from defaults
 		opts := make(map[string]string)
@@ -302,6 +302,10 @@ func findEnclosingClassMethod(node *ast.Node) *ast.Node {
 var NoAccessStateInSetstateRule = rule.Rule{
 	Name:   "react/no-access-state-in-setstate",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"useCallback"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		pragma := reactutil.GetReactPragma(ctx.Settings)
 		createClass := reactutil.GetReactCreateClass(ctx.Settings)
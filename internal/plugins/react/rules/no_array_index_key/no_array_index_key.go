@@ -145,6 +145,10 @@ func isImportSpecifierFromReact(ctx rule.RuleContext, ident *ast.Node) bool {
 var NoArrayIndexKeyRule = rule.Rule{
 	Name:   "react/no-array-index-key",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"noArrayIndex"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		pragma := reactutil.GetReactPragma(ctx.Settings)
 
@@ -21,6 +21,10 @@ const (
 var NoChildrenPropRule = rule.Rule{
 	Name:   "react/no-children-prop",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"nestChildren", "passChildrenAsArgs", "passFunctionAsArgs", "nestFunction"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		allowFunctions := false
 		if len(options) > 0 {
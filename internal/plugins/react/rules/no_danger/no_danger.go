@@ -21,6 +21,10 @@ var dangerousProps = map[string]bool{
 var NoDangerRule = rule.Rule{
 	Name:   "react/no-danger",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"dangerousProp"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		var customComponentNames []string
 		if len(options) > 0 {
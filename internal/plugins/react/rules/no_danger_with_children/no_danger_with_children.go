@@ -43,6 +43,10 @@ const dangerWithChildrenMessage = "Only set one of `children` or `props.dangerou
 var NoDangerWithChildrenRule = rule.Rule{
 	Name:   "react/no-danger-with-children",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"dangerWithChildren"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		// resolveObjectLiteralInit walks an Identifier back to its
 		// VariableDeclaration via the TypeChecker and returns the initializer
@@ -325,6 +325,10 @@ var defaultDeprecatedMessages = func() map[string]string {
 var NoDeprecatedRule = rule.Rule{
 	Name:   "react/no-deprecated",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"deprecated"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		// Determine pragma: `@jsx` directive in source wins over
 		// `settings.react.pragma`, matching upstream's `pragmaUtil.getFromContext`.
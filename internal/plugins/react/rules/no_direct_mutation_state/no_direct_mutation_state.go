@@ -83,6 +83,10 @@ func isThisStateMember(node *ast.Node) bool {
 var NoDirectMutationStateRule = rule.Rule{
 	Name:   "react/no-direct-mutation-state",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"noDirectMutation"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		pragma := reactutil.GetReactPragma(ctx.Settings)
 		createClass := reactutil.GetReactCreateClass(ctx.Settings)
@@ -39,6 +39,10 @@ func matchesFindDOMNodeCallee(callee *ast.Node) bool {
 var NoFindDomNodeRule = rule.Rule{
 	Name:   "react/no-find-dom-node",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"noFindDOMNode"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindCallExpression: func(node *ast.Node) {
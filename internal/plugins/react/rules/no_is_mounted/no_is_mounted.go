@@ -26,6 +26,10 @@ func isPropertyOrMethodDefinition(node *ast.Node) bool {
 var NoIsMountedRule = rule.Rule{
 	Name:   "react/no-is-mounted",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"noIsMounted"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindCallExpression: func(node *ast.Node) {
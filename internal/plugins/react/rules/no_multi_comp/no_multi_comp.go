@@ -328,6 +328,10 @@ func isStatelessKindForIgnore(node *ast.Node) bool {
 var NoMultiCompRule = rule.Rule{
 	Name:   "react/no-multi-comp",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"onlyOneComponent"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 		pragma := reactutil.GetReactPragma(ctx.Settings)
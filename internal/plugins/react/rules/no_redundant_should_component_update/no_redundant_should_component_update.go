@@ -51,6 +51,10 @@ func classDisplayName(node *ast.Node) string {
 var NoRedundantShouldComponentUpdateRule = rule.Rule{
 	Name:   "react/no-redundant-should-component-update",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"noShouldCompUpdate"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		pragma := reactutil.GetReactPragma(ctx.Settings)
 
@@ -116,6 +116,10 @@ func matchedObjectName(call *ast.CallExpression, pattern *regexp.Regexp) string
 var NoRenderReturnValueRule = rule.Rule{
 	Name:   "react/no-render-return-value",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"noReturnValue"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		pattern := calleeObjectRe(ctx.Settings)
 
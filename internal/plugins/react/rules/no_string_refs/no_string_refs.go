@@ -14,6 +14,10 @@ var schemaJSON []byte
 var NoStringRefsRule = rule.Rule{
 	Name:   "react/no-string-refs",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"stringInRefDeprecated", "thisRefsDeprecated"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		detectTemplateLiterals := false
 		if len(options) > 0 {
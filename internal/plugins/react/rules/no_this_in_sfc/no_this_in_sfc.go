@@ -41,6 +41,10 @@ func isPropertyOwnedSFC(component *ast.Node) bool {
 var NoThisInSfcRule = rule.Rule{
 	Name:   "react/no-this-in-sfc",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"noThisInSFC"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		pragma := reactutil.GetReactPragma(ctx.Settings)
 		wrappers := reactutil.GetComponentWrapperFunctions(ctx.Settings, pragma)
@@ -62,7 +62,11 @@ var lifecycleStatic = []string{"getDerivedStateFromProps"}
 var NoTyposRule = rule.Rule{
 	Name:   "react/no-typos",
 	Schema: rule.EmptyArraySchema,
-	Run:    runRule,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"typoPropType", "typoPropTypeChain", "staticLifecycleMethod", "typoLifecycleMethod", "noPropTypesBinding", "noReactBinding"},
+	},
+	Run: runRule,
 }
 
 func runRule(ctx rule.RuleContext, options []any) rule.RuleListeners {
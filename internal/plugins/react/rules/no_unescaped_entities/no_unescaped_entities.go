@@ -101,6 +101,11 @@ func parseEntities(options []any) []entity {
 var NoUnescapedEntitiesRule = rule.Rule{
 	Name:   "react/no-unescaped-entities",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:           rule.RuleTypeProblem,
+		HasSuggestions: true,
+		MessageIds:     []string{"unescapedEntity", "replaceWithAlt", "unescapedEntityAlts"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		entities := parseEntities(options)
 		if len(entities) == 0 {
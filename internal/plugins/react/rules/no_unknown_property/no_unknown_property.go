@@ -22,7 +22,12 @@ var schemaJSON []byte
 var NoUnknownPropertyRule = rule.Rule{
 	Name:   "react/no-unknown-property",
 	Schema: rule.NewSchema(schemaJSON),
-	Run:    runRule,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"dataLowercaseRequired", "invalidPropOnTag", "unknownPropWithStandardName", "unknownProp"},
+	},
+	Run: runRule,
 }
 
 // domAttributeNames maps HTML-cased attribute names to the React DOM property
@@ -85,6 +85,10 @@ func parseOptions(options []any) bool {
 var NoUnsafeRule = rule.Rule{
 	Name:   "react/no-unsafe",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"unsafeMethod"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		// `testReactVersion(context, '>= 16.3.0')` — at React < 16.3 the
 		// `UNSAFE_` lifecycle aliases didn't exist, so the rule disables
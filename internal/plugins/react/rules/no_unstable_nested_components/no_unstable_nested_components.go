@@ -558,6 +558,9 @@ func generateErrorMessageWithParentName(parentName string) string {
 var NoUnstableNestedComponentsRule = rule.Rule{
 	Name:   "react/no-unstable-nested-components",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type: rule.RuleTypeProblem,
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 		// componentEnv bundles every per-invocation derivative the helpers
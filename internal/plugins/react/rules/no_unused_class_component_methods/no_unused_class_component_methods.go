@@ -426,6 +426,10 @@ func getClassName(classNode *ast.Node) string {
 var NoUnusedClassComponentMethodsRule = rule.Rule{
 	Name:   "react/no-unused-class-component-methods",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"unusedWithClass", "unused"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		pragma := reactutil.GetReactPragma(ctx.Settings)
 		createClass := reactutil.GetReactCreateClass(ctx.Settings)
@@ -904,6 +904,10 @@ func processElementAccess(ci *classInfo, node *ast.Node) {
 var NoUnusedStateRule = rule.Rule{
 	Name:   "react/no-unused-state",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"unusedStateField"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		pragma := reactutil.GetReactPragma(ctx.Settings)
 		createClass := reactutil.GetReactCreateClass(ctx.Settings)
@@ -117,6 +117,10 @@ func containsArg(args *ast.NodeList, target *ast.Node) bool {
 var PreferEs6ClassRule = rule.Rule{
 	Name:   "react/prefer-es6-class",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"shouldUseES6Class", "shouldUseCreateClass"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		mode := parseMode(options)
 		pragma := reactutil.GetReactPragma(ctx.Settings)
@@ -964,6 +964,10 @@ func analyzeES5Object(obj *ast.Node, flags *componentFlags, pragma, createClass
 var PreferStatelessFunctionRule = rule.Rule{
 	Name:   "react/prefer-stateless-function",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"componentShouldBePure"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 		pragma := reactutil.GetReactPragma(ctx.Settings)
@@ -12,6 +12,9 @@ import (
 var ReactInJsxScopeRule = rule.Rule{
 	Name:   "react/react-in-jsx-scope",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type: rule.RuleTypeProblem,
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{}
 	},
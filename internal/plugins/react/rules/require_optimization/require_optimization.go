@@ -277,6 +277,10 @@ func methodNameIsSCU(node *ast.Node) bool {
 var RequireOptimizationRule = rule.Rule{
 	Name:   "react/require-optimization",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"noShouldComponentUpdate"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 		pragma := reactutil.GetReactPragma(ctx.Settings)
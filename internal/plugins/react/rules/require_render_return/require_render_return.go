@@ -183,6 +183,10 @@ func renderReturns(fn *ast.Node) bool {
 var RequireRenderReturnRule = rule.Rule{
 	Name:   "react/require-render-return",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"noRenderReturn"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		pragma := reactutil.GetReactPragma(ctx.Settings)
 		createClass := reactutil.GetReactCreateClass(ctx.Settings)
@@ -17,6 +17,11 @@ var schemaJSON []byte
 var SelfClosingCompRule = rule.Rule{
 	Name:   "react/self-closing-comp",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"notSelfClosing"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		checkComponent := true
 		checkHTML := true
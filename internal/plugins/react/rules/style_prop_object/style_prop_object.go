@@ -15,6 +15,10 @@ var schemaJSON []byte
 var StylePropObjectRule = rule.Rule{
 	Name:   "react/style-prop-object",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"stylePropNotObject"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		// Parse the `allow` option: list of component names to skip
 		var allowedComponents map[string]bool
@@ -31,6 +31,10 @@ var voidElements = map[string]bool{
 var VoidDomElementsNoChildrenRule = rule.Rule{
 	Name:   "react/void-dom-elements-no-children",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"noChildrenInVoidEl"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindCallExpression: func(node *ast.Node) {
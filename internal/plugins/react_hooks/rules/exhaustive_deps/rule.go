@@ -140,6 +140,11 @@ func buildDependencyArraySuggestion(
 var ExhaustiveDepsRule = rule.Rule{
 	Name:   "react-hooks/exhaustive-deps",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:           rule.RuleTypeProblem,
+		Fixable:        rule.FixableCode,
+		HasSuggestions: true,
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options, ctx.Settings)
 		sf := ctx.SourceFile
@@ -578,6 +578,9 @@ func reportRange(sourceText string, node *ast.Node) core.TextRange {
 var RulesOfHooksRule = rule.Rule{
 	Name:   "react-hooks/rules-of-hooks",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type: rule.RuleTypeProblem,
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		sf := ctx.SourceFile
 		if !sourceMayUseHooks(sf) {
@@ -363,6 +363,10 @@ func (block commentBlock) commentAt(offset int) *ast.CommentRange {
 var NoCommentedOutTestsRule = rule.Rule{
 	Name:   "rstest/no-commented-out-tests",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"commentedTests"},
+	},
 	Run: func(ctx rule.RuleContext, _ []any) rule.RuleListeners {
 		sourceText := ctx.SourceFile.Text()
 		if !mayContainCommentedRstestRoot(sourceText) {
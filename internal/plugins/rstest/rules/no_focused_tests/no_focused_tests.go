@@ -27,6 +27,11 @@ func buildErrorSuggestRemoveFocusMessage() rule.RuleMessage {
 var NoFocusedTestsRule = rule.Rule{
 	Name:   "rstest/no-focused-tests",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:           rule.RuleTypeSuggestion,
+		HasSuggestions: true,
+		MessageIds:     []string{"focusedTest", "suggestRemoveFocus"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		analysis := rstestUtils.GetRstestCallAnalysis(ctx)
 		suggested := map[*ast.Node]bool{}
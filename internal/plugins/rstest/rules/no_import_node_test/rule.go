@@ -89,6 +89,11 @@ func replacementForModuleSpecifier(sourceFile *ast.SourceFile, node *ast.Node) (
 var NoImportNodeTestRule = rule.Rule{
 	Name:   "rstest/no-import-node-test",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"noImportNodeTest"},
+	},
 	Run: func(ctx rule.RuleContext, _ []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindImportDeclaration: func(node *ast.Node) {
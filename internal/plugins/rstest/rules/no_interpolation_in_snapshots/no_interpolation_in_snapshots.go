@@ -130,6 +130,10 @@ func hasInterpolatedTemplateArgument(arguments *ast.NodeList) bool {
 var NoInterpolationInSnapshotsRule = rule.Rule{
 	Name:   "rstest/no-interpolation-in-snapshots",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"noInterpolation"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		if !sourceMayContainInterpolatedSnapshot(ctx.SourceFile) {
 			return rule.RuleListeners{}
@@ -900,6 +900,11 @@ func checkBlock(
 var PreferCalledExactlyOnceWithRule = rule.Rule{
 	Name:   "rstest/prefer-called-exactly-once-with",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"preferCalledExactlyOnceWith"},
+	},
 	Run: func(ctx rule.RuleContext, _ []any) rule.RuleListeners {
 		analysis := rstestUtils.GetRstestCallAnalysis(ctx)
 		// The linter traverses SourceFile children but does not dispatch a
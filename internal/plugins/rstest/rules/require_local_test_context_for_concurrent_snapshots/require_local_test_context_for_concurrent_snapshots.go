@@ -27,6 +27,10 @@ var RequireLocalTestContextForConcurrentSnapshotsRule = rule.Rule{
 	// requirement keeps the rule off source-only programs instead.
 	RequiresTypeInfo: true,
 	Schema:           rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"requireLocalTestContext"},
+	},
 	Run: func(ctx rule.RuleContext, _ []any) rule.RuleListeners {
 		analysis := rstestUtils.GetRstestCallAnalysis(ctx)
 		// The ownership index behind this context is built on the first query,
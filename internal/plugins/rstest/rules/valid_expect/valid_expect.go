@@ -273,6 +273,11 @@ func reportBrokenChain(ctx rule.RuleContext, parsed *rstestUtils.ParsedRstestExp
 var ValidExpectRule = rule.Rule{
 	Name:   "rstest/valid-expect",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"tooManyArgs", "notEnoughArgs", "modifierUnknown", "matcherNotFound", "matcherNotCalled", "asyncMustBeAwaited", "promisesWithAsyncAssertionsMustBeAwaited"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 		analysis := rstestUtils.GetRstestCallAnalysis(ctx)
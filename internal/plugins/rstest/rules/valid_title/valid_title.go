@@ -413,6 +413,11 @@ func rstestEmptyFunctionName(kind rstestUtils.RstestFnType) string {
 var ValidTitleRule = rule.Rule{
 	Name:   "rstest/valid-title",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"invalidPattern", "titleMustBeString", "emptyTitle", "invalidEachSpecifier", "disallowedWord", "accidentalSpace", "duplicatePrefix", "mustNotMatchCustom", "mustNotMatch", "mustMatchCustom", "mustMatch"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		co := parseCompiledOptions(options)
 		if len(co.invalidPatterns) > 0 {
@@ -183,6 +183,10 @@ func checkBodyForOverloadMethods(ctx rule.RuleContext, node *ast.Node) {
 var AdjacentOverloadSignaturesRule = rule.CreateRule(rule.Rule{
 	Name:   "adjacent-overload-signatures",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"adjacentSignature"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		// Check the source file at the beginning
 		checkBodyForOverloadMethods(ctx, &ctx.SourceFile.Node)
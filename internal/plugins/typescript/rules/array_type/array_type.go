@@ -278,6 +278,11 @@ func buildArrayFixes(
 var ArrayTypeRule = rule.CreateRule(rule.Rule{
 	Name:   "array-type",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"errorStringArray", "errorStringArrayReadonly", "errorStringArraySimple", "errorStringArraySimpleReadonly", "errorStringGeneric", "errorStringGenericSimple"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 
@@ -46,6 +46,11 @@ var AwaitThenableRule = rule.CreateRule(rule.Rule{
 	Name:             "await-thenable",
 	Schema:           rule.EmptyArraySchema,
 	RequiresTypeInfo: true,
+	Meta: rule.RuleMeta{
+		Type:           rule.RuleTypeProblem,
+		HasSuggestions: true,
+		MessageIds:     []string{"await", "removeAwait", "forAwaitOfNonAsyncIterable", "convertToOrdinaryFor", "awaitUsingOfNonAsyncDisposable"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindAwaitExpression: func(node *ast.Node) {
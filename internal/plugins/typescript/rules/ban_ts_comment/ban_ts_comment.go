@@ -60,7 +60,12 @@ var (
 var BanTsCommentRule = rule.CreateRule(rule.Rule{
 	Name:   "ban-ts-comment",
 	Schema: rule.NewSchema(schemaJSON),
-	Run:    run,
+	Meta: rule.RuleMeta{
+		Type:           rule.RuleTypeProblem,
+		HasSuggestions: true,
+		MessageIds:     []string{"tsIgnoreInsteadOfExpectError", "replaceTsIgnoreWithTsExpectError", "tsDirectiveCommentRequiresDescription", "tsDirectiveCommentDescriptionNotMatchPattern", "tsDirectiveComment"},
+	},
+	Run: run,
 })
 
 func run(ctx rule.RuleContext, _options []any) rule.RuleListeners {
@@ -20,7 +20,12 @@ var enableDisableRegex = regexp.MustCompile(`^\s*tslint:(enable|disable)(?:-(lin
 var BanTslintCommentRule = rule.CreateRule(rule.Rule{
 	Name:   "ban-tslint-comment",
 	Schema: rule.EmptyArraySchema,
-	Run:    run,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"commentDetected"},
+	},
+	Run: run,
 })
 
 func run(ctx rule.RuleContext, _ []any) rule.RuleListeners {
@@ -194,6 +194,11 @@ func isFunction(node *ast.Node) bool {
 var ClassLiteralPropertyStyleRule = rule.CreateRule(rule.Rule{
 	Name:   "class-literal-property-style",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:           rule.RuleTypeSuggestion,
+		HasSuggestions: true,
+		MessageIds:     []string{"preferFieldStyle", "preferFieldStyleSuggestion", "preferGetterStyle", "preferGetterStyleSuggestion"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		style := "fields" // default option
 		if len(options) > 0 {
@@ -21,7 +21,11 @@ var schemaJSON []byte
 var ClassMethodsUseThisRule = rule.CreateRule(rule.Rule{
 	Name:   "class-methods-use-this",
 	Schema: rule.NewSchema(schemaJSON),
-	Run:    run,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"missingThis"},
+	},
+	Run: run,
 })
 
 type ignoreClassesMode int
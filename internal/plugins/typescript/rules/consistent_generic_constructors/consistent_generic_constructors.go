@@ -31,7 +31,11 @@ func parseOptions(options []any) ConsistentGenericConstructorsOptions {
 var ConsistentGenericConstructorsRule = rule.CreateRule(rule.Rule{
 	Name:   "consistent-generic-constructors",
 	Schema: rule.NewSchema(schemaJSON),
-	Run:    run,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"preferTypeAnnotation", "preferConstructor"},
+	},
+	Run: run,
 })
 
 func run(ctx rule.RuleContext, options []any) rule.RuleListeners {
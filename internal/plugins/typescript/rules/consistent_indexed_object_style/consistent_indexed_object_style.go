@@ -41,7 +41,13 @@ var (
 var ConsistentIndexedObjectStyleRule = rule.CreateRule(rule.Rule{
 	Name:   "consistent-indexed-object-style",
 	Schema: rule.NewSchema(schemaJSON),
-	Run:    run,
+	Meta: rule.RuleMeta{
+		Type:           rule.RuleTypeSuggestion,
+		Fixable:        rule.FixableCode,
+		HasSuggestions: true,
+		MessageIds:     []string{"preferRecord", "preferRecordSuggestion", "preferIndexSignature", "preferIndexSignatureSuggestion"},
+	},
+	Run: run,
 })
 
 func parseOptions(options []any) ConsistentIndexedObjectStyleOptions {
@@ -21,7 +21,11 @@ var ConsistentReturnRule = rule.CreateRule(rule.Rule{
 	Name:             "consistent-return",
 	Schema:           rule.NewSchema(schemaJSON),
 	RequiresTypeInfo: true,
-	Run:              run,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"missingReturnValue"},
+	},
+	Run: run,
 })
 
 // functionInfo tracks information about a function's return statements
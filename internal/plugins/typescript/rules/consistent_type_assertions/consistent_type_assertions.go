@@ -229,7 +229,13 @@ func (e consistentTypeAssertionsEdits) angleToAsFix(
 var ConsistentTypeAssertionsRule = rule.CreateRule(rule.Rule{
 	Name:   "consistent-type-assertions",
 	Schema: rule.NewSchema(schemaJSON),
-	Run:    run,
+	Meta: rule.RuleMeta{
+		Type:           rule.RuleTypeSuggestion,
+		Fixable:        rule.FixableCode,
+		HasSuggestions: true,
+		MessageIds:     []string{"unexpectedObjectTypeAssertion", "unexpectedArrayTypeAssertion", "as", "angle-bracket", "never"},
+	},
+	Run: run,
 })
 
 func run(ctx rule.RuleContext, options []any) rule.RuleListeners {
@@ -185,7 +185,12 @@ func (f consistentTypeDefinitionsFixer) interfaceFix(node *ast.Node, interfaceDe
 var ConsistentTypeDefinitionsRule = rule.CreateRule(rule.Rule{
 	Name:   "consistent-type-definitions",
 	Schema: rule.NewSchema(schemaJSON),
-	Run:    run,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"interfaceOverType", "typeOverInterface"},
+	},
+	Run: run,
 })
 
 var (
@@ -19,7 +19,11 @@ var ConsistentTypeExportsRule = rule.CreateRule(rule.Rule{
 	Name:             "consistent-type-exports",
 	Schema:           rule.NewSchema(schemaJSON),
 	RequiresTypeInfo: true,
-	Run:              run,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"typeOverValue", "singleExportIsType", "multipleExportsAreTypes"},
+	},
+	Run: run,
 })
 
 func parseOptions(options []any) ConsistentTypeExportsOptions {
@@ -20,7 +20,11 @@ type ConsistentTypeImportsOptions struct {
 var ConsistentTypeImportsRule = rule.CreateRule(rule.Rule{
 	Name:   "consistent-type-imports",
 	Schema: rule.NewSchema(schemaJSON),
-	Run:    run,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"avoidImportType", "noImportTypeAnnotations"},
+	},
+	Run: run,
 })
 
 func parseOptions(options []any) ConsistentTypeImportsOptions {
@@ -9,7 +9,11 @@ import (
 var DefaultParamLastRule = rule.CreateRule(rule.Rule{
 	Name:   "default-param-last",
 	Schema: rule.EmptyArraySchema,
-	Run:    run,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"shouldBeLast"},
+	},
+	Run: run,
 })
 
 var shouldBeLastMessage = rule.RuleMessage{
@@ -74,6 +74,9 @@ var DotNotationRule = rule.CreateRule(rule.Rule{
 	Name:             "dot-notation",
 	Schema:           rule.NewSchema(schemaJSON),
 	RequiresTypeInfo: true,
+	Meta: rule.RuleMeta{
+		Type: rule.RuleTypeSuggestion,
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 		baseListeners := coreDotNotation.DotNotationRule.Run(ctx, options)
@@ -80,7 +80,11 @@ type functionInfo struct {
 var ExplicitFunctionReturnTypeRule = rule.CreateRule(rule.Rule{
 	Name:   "explicit-function-return-type",
 	Schema: rule.NewSchema(schemaJSON),
-	Run:    run,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"missingReturnType"},
+	},
+	Run: run,
 })
 
 func run(ctx rule.RuleContext, rawOptions []any) rule.RuleListeners {
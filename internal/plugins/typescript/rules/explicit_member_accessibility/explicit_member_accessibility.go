@@ -320,6 +320,12 @@ func isClassMember(node *ast.Node) bool {
 var ExplicitMemberAccessibilityRule = rule.CreateRule(rule.Rule{
 	Name:   "explicit-member-accessibility",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:           rule.RuleTypeSuggestion,
+		Fixable:        rule.FixableCode,
+		HasSuggestions: true,
+		MessageIds:     []string{"missingAccessibility", "unwantedPublicAccessibility", "addExplicitAccessibility"},
+	},
 	Run: func(ctx rule.RuleContext, rawOptions []any) rule.RuleListeners {
 		opts := parseOptions(rawOptions)
 		sf := ctx.SourceFile
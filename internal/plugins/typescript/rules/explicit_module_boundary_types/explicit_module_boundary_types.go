@@ -73,7 +73,11 @@ var ExplicitModuleBoundaryTypesRule = rule.CreateRule(rule.Rule{
 	// require type info — gap files (no tsconfig project) are skipped by
 	// the linter, matching how every other type-aware rule behaves.
 	RequiresTypeInfo: true,
-	Run:              run,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"missingReturnType"},
+	},
+	Run: run,
 })
 
 func run(ctx rule.RuleContext, rawOptions []any) rule.RuleListeners {
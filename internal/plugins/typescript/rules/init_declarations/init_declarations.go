@@ -28,6 +28,9 @@ var schemaJSON []byte
 var InitDeclarationsRule = rule.CreateRule(rule.Rule{
 	Name:   "init-declarations",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type: rule.RuleTypeSuggestion,
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 
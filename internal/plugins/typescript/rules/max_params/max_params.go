@@ -20,7 +20,11 @@ var schemaJSON []byte
 var MaxParamsRule = rule.CreateRule(rule.Rule{
 	Name:   "max-params",
 	Schema: rule.NewSchema(schemaJSON),
-	Run:    run,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"exceed"},
+	},
+	Run: run,
 })
 
 const defaultMax = 3
@@ -1032,6 +1032,10 @@ func getClassMembers(node *ast.Node) []*ast.Node {
 var MemberOrderingRule = rule.CreateRule(rule.Rule{
 	Name:   "member-ordering",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"incorrectGroupOrder", "incorrectOrder", "incorrectRequiredMembersOrder"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 
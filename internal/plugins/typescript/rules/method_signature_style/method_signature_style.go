@@ -37,7 +37,12 @@ func messageErrorProperty() rule.RuleMessage {
 var MethodSignatureStyleRule = rule.CreateRule(rule.Rule{
 	Name:   "method-signature-style",
 	Schema: rule.NewSchema(schemaJSON),
-	Run:    run,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"errorMethod", "errorProperty"},
+	},
+	Run: run,
 })
 
 // containsThisType recursively checks whether the given type node (or any of
@@ -24,7 +24,11 @@ var schemaJSON []byte
 var NamingConventionRule = rule.CreateRule(rule.Rule{
 	Name:   "naming-convention",
 	Schema: rule.NewSchema(schemaJSON),
-	Run:    run,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"doesNotMatchFormat", "doesNotMatchFormatTrimmed", "missingAffix", "satisfyCustom", "unexpectedUnderscore", "missingUnderscore"},
+	},
+	Run: run,
 })
 
 // ---- Enums ----
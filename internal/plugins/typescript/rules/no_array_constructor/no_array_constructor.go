@@ -121,6 +121,11 @@ func noArrayConstructorListeners(ctx rule.RuleContext) rule.RuleListeners {
 var NoArrayConstructorRule = rule.CreateRule(rule.Rule{
 	Name:   "no-array-constructor",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"useLiteral"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		if !sourceMayUseArrayConstructor(ctx.SourceFile) {
 			return nil
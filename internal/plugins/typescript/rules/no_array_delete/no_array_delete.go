@@ -25,6 +25,11 @@ var NoArrayDeleteRule = rule.CreateRule(rule.Rule{
 	Name:             "no-array-delete",
 	Schema:           rule.EmptyArraySchema,
 	RequiresTypeInfo: true,
+	Meta: rule.RuleMeta{
+		Type:           rule.RuleTypeProblem,
+		HasSuggestions: true,
+		MessageIds:     []string{"noArrayDelete", "useSplice"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		isUnderlyingTypeArray := func(t *checker.Type) bool {
 			if utils.IsTypeFlagSet(t, checker.TypeFlagsUnion) {
@@ -70,6 +70,10 @@ var NoBaseToStringRule = rule.CreateRule(rule.Rule{
 	Name:             "no-base-to-string",
 	Schema:           rule.NewSchema(schemaJSON),
 	RequiresTypeInfo: true,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"baseArrayJoin", "baseToString"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 
@@ -61,6 +61,11 @@ func buildWrapUpLeftMessage(op string) rule.RuleMessage {
 var NoConfusingNonNullAssertionRule = rule.CreateRule(rule.Rule{
 	Name:   "no-confusing-non-null-assertion",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:           rule.RuleTypeProblem,
+		HasSuggestions: true,
+		MessageIds:     []string{"confusingAssign", "confusingEqual", "confusingOperator", "notNeedInAssign", "notNeedInEqualTest", "notNeedInOperator", "wrapUpLeft"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindBinaryExpression: func(node *ast.Node) {
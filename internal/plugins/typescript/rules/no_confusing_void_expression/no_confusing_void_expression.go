@@ -89,6 +89,12 @@ var NoConfusingVoidExpressionRule = rule.CreateRule(rule.Rule{
 	Name:             "no-confusing-void-expression",
 	Schema:           rule.NewSchema(schemaJSON),
 	RequiresTypeInfo: true,
+	Meta: rule.RuleMeta{
+		Type:           rule.RuleTypeProblem,
+		Fixable:        rule.FixableCode,
+		HasSuggestions: true,
+		MessageIds:     []string{"invalidVoidExpr", "invalidVoidExprArrow", "invalidVoidExprArrowWrapVoid", "invalidVoidExprReturn", "invalidVoidExprReturnLast", "invalidVoidExprReturnWrapVoid", "invalidVoidExprWrapVoid", "voidExprWrapVoid"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 
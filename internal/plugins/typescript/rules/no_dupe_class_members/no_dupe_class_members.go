@@ -105,6 +105,10 @@ func (state *classState) register(name string, static bool, kind memberKind) boo
 var NoDupeClassMembersRule = rule.CreateRule(rule.Rule{
 	Name:   "no-dupe-class-members",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"unexpected"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		checkClass := func(node *ast.Node) {
 			members := node.Members()
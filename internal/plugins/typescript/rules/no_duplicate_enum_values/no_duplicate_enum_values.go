@@ -153,6 +153,10 @@ func duplicateValueMessage(value enumValue) rule.RuleMessage {
 var NoDuplicateEnumValuesRule = rule.CreateRule(rule.Rule{
 	Name:   "no-duplicate-enum-values",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"duplicateValue"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindEnumDeclaration: func(node *ast.Node) {
@@ -68,6 +68,11 @@ var NoDuplicateTypeConstituentsRule = rule.CreateRule(rule.Rule{
 	Name:             "no-duplicate-type-constituents",
 	Schema:           rule.NewSchema(schemaJSON),
 	RequiresTypeInfo: true,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"duplicate", "unnecessary"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 
@@ -27,6 +27,10 @@ func isAllowedDeleteArgument(argument *ast.Node) bool {
 var NoDynamicDeleteRule = rule.CreateRule(rule.Rule{
 	Name:   "no-dynamic-delete",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"dynamicDelete"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindDeleteExpression: func(node *ast.Node) {
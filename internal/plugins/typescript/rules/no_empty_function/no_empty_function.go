@@ -18,6 +18,9 @@ var schemaJSON []byte
 var NoEmptyFunctionRule = rule.CreateRule(rule.Rule{
 	Name:   "no-empty-function",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type: rule.RuleTypeSuggestion,
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return core.RunTSESLint(ctx, normalizeConstructorOptions(options))
 	},
@@ -35,6 +35,13 @@ var NoEmptyInterfaceRule = rule.CreateRule(rule.Rule{
 	Name:             "no-empty-interface",
 	Schema:           rule.NewSchema(schemaJSON),
 	RequiresTypeInfo: true,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		Fixable:    rule.FixableCode,
+		Deprecated: true,
+		ReplacedBy: []string{"@typescript-eslint/no-empty-object-type"},
+		MessageIds: []string{"noEmpty", "noEmptyWithSuper"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 
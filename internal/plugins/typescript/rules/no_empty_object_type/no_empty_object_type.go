@@ -171,6 +171,11 @@ func interfaceFixRange(ctx rule.RuleContext, interfaceDecl *ast.InterfaceDeclara
 var NoEmptyObjectTypeRule = rule.CreateRule(rule.Rule{
 	Name:   "no-empty-object-type",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:           rule.RuleTypeSuggestion,
+		HasSuggestions: true,
+		MessageIds:     []string{"noEmptyInterface", "noEmptyInterfaceWithSuper", "noEmptyObject", "replaceEmptyInterface", "replaceEmptyInterfaceWithSuper", "replaceEmptyObjectType"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 
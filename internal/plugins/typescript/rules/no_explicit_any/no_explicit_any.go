@@ -123,6 +123,12 @@ func anyKeywordRange(sourceFile *ast.SourceFile, node *ast.Node) core.TextRange
 var NoExplicitAnyRule = rule.CreateRule(rule.Rule{
 	Name:   "no-explicit-any",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:           rule.RuleTypeSuggestion,
+		Fixable:        rule.FixableCode,
+		HasSuggestions: true,
+		MessageIds:     []string{"unexpectedAny", "suggestUnknown", "suggestNever", "suggestPropertyKey"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 
@@ -10,6 +10,11 @@ import (
 var NoExtraNonNullAssertionRule = rule.CreateRule(rule.Rule{
 	Name:   "no-extra-non-null-assertion",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"noExtraNonNullAssertion"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		msg := rule.RuleMessage{
 			Id:          "noExtraNonNullAssertion",
@@ -46,6 +46,10 @@ func parseOptions(options []any) NoExtraneousClassOptions {
 var NoExtraneousClassRule = rule.CreateRule(rule.Rule{
 	Name:   "no-extraneous-class",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"empty", "onlyConstructor", "onlyStatic"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 
@@ -110,6 +110,11 @@ var NoFloatingPromisesRule = rule.CreateRule(rule.Rule{
 	Name:             "no-floating-promises",
 	Schema:           rule.NewSchema(schemaJSON),
 	RequiresTypeInfo: true,
+	Meta: rule.RuleMeta{
+		Type:           rule.RuleTypeProblem,
+		HasSuggestions: true,
+		MessageIds:     []string{"floating", "floatingFixAwait", "floatingFixVoid", "floatingPromiseArray", "floatingPromiseArrayVoid", "floatingUselessRejectionHandler", "floatingUselessRejectionHandlerVoid", "floatingVoid"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 		isHigherPrecedenceThanUnary := func(node *ast.Node) bool {
@@ -18,6 +18,10 @@ var NoForInArrayRule = rule.CreateRule(rule.Rule{
 	Name:             "no-for-in-array",
 	Schema:           rule.EmptyArraySchema,
 	RequiresTypeInfo: true,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"forInViolation"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		hasArrayishLength := func(t *checker.Type) bool {
 			lengthProperty := checker.Checker_getPropertyOfType(ctx.TypeChecker, t, "length")
@@ -29,6 +29,10 @@ var NoImpliedEvalRule = rule.CreateRule(rule.Rule{
 	Name:             "no-implied-eval",
 	Schema:           rule.EmptyArraySchema,
 	RequiresTypeInfo: true,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"noFunctionConstructor", "noImpliedEvalError"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		getCalleeName := func(node *ast.Expression) string {
 			if ast.IsIdentifier(node) {
@@ -17,6 +17,11 @@ func buildUseTopLevelQualifierMessage() rule.RuleMessage {
 var NoImportTypeSideEffectsRule = rule.CreateRule(rule.Rule{
 	Name:   "no-import-type-side-effects",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"useTopLevelQualifier"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindImportDeclaration: func(node *ast.Node) {
@@ -295,6 +295,11 @@ func buildNoInferrableTypesFixes(sourceText string, typeAnnotation *ast.Node, po
 var NoInferrableTypesRule = rule.CreateRule(rule.Rule{
 	Name:   "no-inferrable-types",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"noInferrableType"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 		sourceText := ctx.SourceFile.Text()
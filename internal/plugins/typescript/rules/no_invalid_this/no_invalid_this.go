@@ -34,7 +34,11 @@ var schemaJSON []byte
 var NoInvalidThisRule = rule.CreateRule(rule.Rule{
 	Name:   "no-invalid-this",
 	Schema: rule.NewSchema(schemaJSON),
-	Run:    run,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"unexpectedThis"},
+	},
+	Run: run,
 })
 
 type ruleOptions struct {
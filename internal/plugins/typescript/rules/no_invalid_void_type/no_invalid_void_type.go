@@ -282,6 +282,10 @@ func hasOverloadSignatures(ctx rule.RuleContext, node *ast.Node) bool {
 var NoInvalidVoidTypeRule = rule.CreateRule(rule.Rule{
 	Name:   "no-invalid-void-type",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"invalidVoidUnionConstituent", "invalidVoidForGeneric", "invalidVoidNotReturnOrGeneric"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 		allowGeneric := isAllowInGenericTruthy(opts)
@@ -125,6 +125,9 @@ var NoLoopFuncRule = rule.CreateRule(rule.Rule{
 	Name:             "no-loop-func",
 	Schema:           rule.EmptyArraySchema,
 	RequiresTypeInfo: true,
+	Meta: rule.RuleMeta{
+		Type: rule.RuleTypeSuggestion,
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		if ctx.TypeChecker == nil {
 			return rule.RuleListeners{}
@@ -20,5 +20,8 @@ var schemaJSON []byte
 var NoMagicNumbersRule = rule.CreateRule(rule.Rule{
 	Name:   "no-magic-numbers",
 	Schema: rule.NewSchema(schemaJSON),
-	Run:    core.RunTSESLint,
+	Meta: rule.RuleMeta{
+		Type: rule.RuleTypeSuggestion,
+	},
+	Run: core.RunTSESLint,
 })
@@ -46,6 +46,12 @@ var NoMeaninglessVoidOperatorRule = rule.CreateRule(rule.Rule{
 	Name:             "no-meaningless-void-operator",
 	Schema:           rule.NewSchema(schemaJSON),
 	RequiresTypeInfo: true,
+	Meta: rule.RuleMeta{
+		Type:           rule.RuleTypeSuggestion,
+		Fixable:        rule.FixableCode,
+		HasSuggestions: true,
+		MessageIds:     []string{"meaninglessVoidOperator", "removeVoid"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 
@@ -40,6 +40,10 @@ func returnsParentType(typeNode *ast.Node, parent *ast.Node) bool {
 var NoMisusedNewRule = rule.CreateRule(rule.Rule{
 	Name:   "no-misused-new",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"errorMessageClass", "errorMessageInterface"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindMethodDeclaration: func(node *ast.Node) {
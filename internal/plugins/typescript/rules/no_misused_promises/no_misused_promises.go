@@ -141,6 +141,10 @@ var NoMisusedPromisesRule = rule.CreateRule(rule.Rule{
 	Name:             "no-misused-promises",
 	Schema:           rule.NewSchema(schemaJSON),
 	RequiresTypeInfo: true,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"conditional", "predicate", "spread", "voidReturnArgument", "voidReturnAttribute", "voidReturnInheritedMethod", "voidReturnProperty", "voidReturnReturnValue", "voidReturnVariable"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 
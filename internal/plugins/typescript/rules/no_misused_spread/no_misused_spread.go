@@ -172,6 +172,11 @@ var NoMisusedSpreadRule = rule.CreateRule(rule.Rule{
 	Name:             "no-misused-spread",
 	Schema:           rule.NewSchema(schemaJSON),
 	RequiresTypeInfo: true,
+	Meta: rule.RuleMeta{
+		Type:           rule.RuleTypeProblem,
+		HasSuggestions: true,
+		MessageIds:     []string{"addAwait", "noArraySpreadInObject", "noClassDeclarationSpreadInObject", "noClassInstanceSpreadInObject", "noFunctionSpreadInObject", "noIterableSpreadInObject", "noMapSpreadInObject", "noPromiseSpreadInObject", "noStringSpread", "replaceMapSpreadInObject"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 
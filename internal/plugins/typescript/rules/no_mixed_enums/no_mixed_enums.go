@@ -26,6 +26,10 @@ var NoMixedEnumsRule = rule.CreateRule(rule.Rule{
 	Name:             "no-mixed-enums",
 	Schema:           rule.EmptyArraySchema,
 	RequiresTypeInfo: true,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"mixed"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		getMemberType := func(node *ast.Node) allowedType {
 			initializer := node.AsEnumMember().Initializer
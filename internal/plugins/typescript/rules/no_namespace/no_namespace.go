@@ -67,6 +67,10 @@ func isDeclaredNamespace(node *ast.Node, isDefinitionFile bool) bool {
 var NoNamespaceRule = rule.CreateRule(rule.Rule{
 	Name:   "no-namespace",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"moduleSyntaxIsPreferred"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseNoNamespaceOptions(options)
 		if opts.AllowDefinitionFiles && ctx.SourceFile.IsDeclarationFile {
@@ -80,6 +80,11 @@ func checkAssignmentsBefore(identName string, node *ast.Node, scope *ast.Node) b
 var NoNonNullAssertedNullishCoalescingRule = rule.CreateRule(rule.Rule{
 	Name:   "no-non-null-asserted-nullish-coalescing",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:           rule.RuleTypeProblem,
+		HasSuggestions: true,
+		MessageIds:     []string{"noNonNullAssertedNullishCoalescing", "suggestRemovingNonNull"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		msg := rule.RuleMessage{
 			Id:          "noNonNullAssertedNullishCoalescing",
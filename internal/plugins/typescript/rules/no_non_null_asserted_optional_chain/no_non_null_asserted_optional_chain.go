@@ -23,6 +23,11 @@ func isPartOfOptionalChain(node *ast.Node) bool {
 var NoNonNullAssertedOptionalChainRule = rule.CreateRule(rule.Rule{
 	Name:   "no-non-null-asserted-optional-chain",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:           rule.RuleTypeProblem,
+		HasSuggestions: true,
+		MessageIds:     []string{"noNonNullOptionalChain", "suggestRemovingNonNull"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		msg := rule.RuleMessage{
 			Id:          "noNonNullOptionalChain",
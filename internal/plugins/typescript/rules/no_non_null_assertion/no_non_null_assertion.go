@@ -113,6 +113,11 @@ func buildOptionalChainSuggestions(
 var NoNonNullAssertionRule = rule.CreateRule(rule.Rule{
 	Name:   "no-non-null-assertion",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:           rule.RuleTypeSuggestion,
+		HasSuggestions: true,
+		MessageIds:     []string{"noNonNull", "suggestOptionalChain"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		msg := rule.RuleMessage{
 			Id:          "noNonNull",
@@ -13,5 +13,8 @@ var schemaJSON []byte
 var NoRedeclareRule = rule.CreateRule(rule.Rule{
 	Name:   "no-redeclare",
 	Schema: rule.NewSchema(schemaJSON),
-	Run:    coreNoRedeclare.RunTSESLint,
+	Meta: rule.RuleMeta{
+		Type: rule.RuleTypeSuggestion,
+	},
+	Run: coreNoRedeclare.RunTSESLint,
 })
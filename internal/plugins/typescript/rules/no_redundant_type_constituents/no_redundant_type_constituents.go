@@ -113,6 +113,10 @@ var NoRedundantTypeConstituentsRule = rule.CreateRule(rule.Rule{
 	Name:             "no-redundant-type-constituents",
 	Schema:           rule.EmptyArraySchema,
 	RequiresTypeInfo: true,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"errorTypeOverrides", "literalOverridden", "overridden", "overrides", "primitiveOverridden"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		var getTypeNodeTypePartFlags func(node *ast.Node) []typeFlagsWithNodeOrType
 		getTypeNodeTypePartFlags = func(node *ast.Node) []typeFlagsWithNodeOrType {
@@ -41,6 +41,10 @@ var noRequireImportsMessage = rule.RuleMessage{
 var NoRequireImportsRule = rule.CreateRule(rule.Rule{
 	Name:   "no-require-imports",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"noRequireImports"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		var optsMap map[string]interface{}
 		if len(options) > 0 {
@@ -37,6 +37,9 @@ var schemaJSON []byte
 var NoRestrictedImportsRule = rule.CreateRule(rule.Rule{
 	Name:   "no-restricted-imports",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type: rule.RuleTypeSuggestion,
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		engine := core.NewEngine(options)
 		if !engine.IsActive() {
@@ -155,6 +155,12 @@ func buildReplacementSuggestions(
 var NoRestrictedTypesRule = rule.CreateRule(rule.Rule{
 	Name:   "no-restricted-types",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:           rule.RuleTypeSuggestion,
+		Fixable:        rule.FixableCode,
+		HasSuggestions: true,
+		MessageIds:     []string{"bannedTypeReplacement", "bannedTypeMessage"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		bannedTypes := map[string]bannedTypeConfig{}
 		if len(options) > 0 {
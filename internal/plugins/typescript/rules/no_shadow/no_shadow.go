@@ -17,5 +17,8 @@ var schemaJSON []byte
 var NoShadowRule = rule.CreateRule(rule.Rule{
 	Name:   "no-shadow",
 	Schema: rule.NewSchema(schemaJSON),
-	Run:    core.RunTSESLint,
+	Meta: rule.RuleMeta{
+		Type: rule.RuleTypeSuggestion,
+	},
+	Run: core.RunTSESLint,
 })
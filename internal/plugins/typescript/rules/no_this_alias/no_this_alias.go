@@ -140,6 +140,10 @@ func configuredThisListener(ctx *rule.RuleContext, opts NoThisAliasOptions) func
 var NoThisAliasRule = rule.CreateRule(rule.Rule{
 	Name:   "no-this-alias",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"thisAssignment", "thisDestructure"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		if len(options) == 0 {
 			return rule.RuleListeners{ast.KindThisKeyword: defaultThisListener(&ctx)}
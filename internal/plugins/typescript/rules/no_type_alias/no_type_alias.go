@@ -130,6 +130,11 @@ func unwrapParenthesized(node *ast.Node) *ast.Node {
 var NoTypeAliasRule = rule.CreateRule(rule.Rule{
 	Name:   "no-type-alias",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		Deprecated: true,
+		MessageIds: []string{"noTypeAlias", "noCompositionAlias"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 
@@ -330,6 +330,11 @@ var NoUnnecessaryConditionRule = rule.CreateRule(rule.Rule{
 	Name:             "no-unnecessary-condition",
 	Schema:           rule.NewSchema(schemaJSON),
 	RequiresTypeInfo: true,
+	Meta: rule.RuleMeta{
+		Type:           rule.RuleTypeSuggestion,
+		HasSuggestions: true,
+		MessageIds:     []string{"alwaysTruthy", "alwaysFalsy", "alwaysTruthyFunc", "alwaysFalsyFunc", "never", "neverNullish", "alwaysNullish", "neverOptionalChain", "suggestRemoveOptionalChain", "noStrictNullCheck", "comparisonBetweenLiteralTypes", "noOverlapBooleanExpression", "typeGuardAlreadyIsType"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 		tc := ctx.TypeChecker
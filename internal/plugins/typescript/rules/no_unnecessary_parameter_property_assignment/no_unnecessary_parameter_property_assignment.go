@@ -22,7 +22,11 @@ var NoUnnecessaryParameterPropertyAssignmentRule = rule.CreateRule(rule.Rule{
 	Name:             "no-unnecessary-parameter-property-assignment",
 	Schema:           rule.EmptyArraySchema,
 	RequiresTypeInfo: true,
-	Run:              run,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"unnecessaryAssign"},
+	},
+	Run: run,
 })
 
 // reportInfo mirrors upstream's per-ClassBody stack frame.
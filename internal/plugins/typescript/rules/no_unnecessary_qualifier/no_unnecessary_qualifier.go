@@ -29,7 +29,12 @@ var NoUnnecessaryQualifierRule = rule.CreateRule(rule.Rule{
 	Name:             "no-unnecessary-qualifier",
 	Schema:           rule.EmptyArraySchema,
 	RequiresTypeInfo: true,
-	Run:              run,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"unnecessaryQualifier"},
+	},
+	Run: run,
 })
 
 func run(ctx rule.RuleContext, options []any) rule.RuleListeners {
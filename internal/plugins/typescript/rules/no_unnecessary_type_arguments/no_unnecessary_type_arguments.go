@@ -30,6 +30,11 @@ var NoUnnecessaryTypeArgumentsRule = rule.CreateRule(rule.Rule{
 	Name:             "no-unnecessary-type-arguments",
 	Schema:           rule.EmptyArraySchema,
 	RequiresTypeInfo: true,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"unnecessaryTypeParameter"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		getTypeParametersFromType := func(node *ast.Node, nodeName *ast.Node) []*ast.Node {
 			symbol := ctx.TypeChecker.GetSymbolAtLocation(nodeName)
@@ -36,6 +36,11 @@ var disambiguationExtensions = []string{tspath.ExtensionCts, tspath.ExtensionMts
 var NoUnnecessaryTypeConstraintRule = rule.CreateRule(rule.Rule{
 	Name:   "no-unnecessary-type-constraint",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:           rule.RuleTypeSuggestion,
+		HasSuggestions: true,
+		MessageIds:     []string{"unnecessaryConstraint", "removeUnnecessaryConstraint"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		needsDisambiguationResolved := false
 		needsDisambiguation := false
@@ -392,6 +392,11 @@ var NoUnnecessaryTypeConversionRule = rule.CreateRule(rule.Rule{
 	Name:             "no-unnecessary-type-conversion",
 	Schema:           rule.EmptyArraySchema,
 	RequiresTypeInfo: true,
+	Meta: rule.RuleMeta{
+		Type:           rule.RuleTypeSuggestion,
+		HasSuggestions: true,
+		MessageIds:     []string{"unnecessaryTypeConversion", "suggestRemove", "suggestSatisfies"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		sourceFile := ctx.SourceFile
 		suggestions := conversionSuggestionBuilder{sourceFile: sourceFile}
@@ -37,6 +37,11 @@ var NoUnnecessaryTypeParametersRule = rule.CreateRule(rule.Rule{
 	Name:             "no-unnecessary-type-parameters",
 	RequiresTypeInfo: true,
 	Schema:           rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:           rule.RuleTypeProblem,
+		HasSuggestions: true,
+		MessageIds:     []string{"replaceUsagesWithConstraint", "sole"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		checkFunctionNode := func(node *ast.Node) { checkNode(ctx, node, "function") }
 		checkClassNode := func(node *ast.Node) { checkNode(ctx, node, "class") }
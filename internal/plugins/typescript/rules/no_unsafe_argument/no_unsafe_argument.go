@@ -158,6 +158,10 @@ var NoUnsafeArgumentRule = rule.CreateRule(rule.Rule{
 	Name:             "no-unsafe-argument",
 	Schema:           rule.EmptyArraySchema,
 	RequiresTypeInfo: true,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"unsafeArgument", "unsafeArraySpread", "unsafeSpread", "unsafeTupleSpread"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		describeType := func(t *checker.Type) string {
 			if utils.IsIntrinsicErrorType(t) {
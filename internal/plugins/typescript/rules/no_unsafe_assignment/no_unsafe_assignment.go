@@ -525,6 +525,10 @@ var NoUnsafeAssignmentRule = rule.CreateRule(rule.Rule{
 	Name:             "no-unsafe-assignment",
 	Schema:           rule.EmptyArraySchema,
 	RequiresTypeInfo: true,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"unsafeAssignment"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		compilerOptions := ctx.Program().Options()
 		isNoImplicitThis := utils.IsStrictCompilerOptionEnabled(
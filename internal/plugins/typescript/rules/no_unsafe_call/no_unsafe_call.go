@@ -39,6 +39,10 @@ var NoUnsafeCallRule = rule.CreateRule(rule.Rule{
 	Name:             "no-unsafe-call",
 	Schema:           rule.EmptyArraySchema,
 	RequiresTypeInfo: true,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"unsafeCall", "unsafeCallThis", "unsafeNew", "unsafeTemplateTag"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		compilerOptions := ctx.Program().Options()
 		// When noImplicitThis is not enabled (considering strict mode), object literal methods
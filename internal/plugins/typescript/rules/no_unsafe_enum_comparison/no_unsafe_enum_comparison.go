@@ -78,6 +78,10 @@ var NoUnsafeEnumComparisonRule = rule.CreateRule(rule.Rule{
 	Name:             "no-unsafe-enum-comparison",
 	Schema:           rule.EmptyArraySchema,
 	RequiresTypeInfo: true,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"mismatchedCase", "mismatchedCondition", "replaceValueWithEnum"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		isMismatchedComparison := func(
 			leftType *checker.Type,
@@ -17,6 +17,10 @@ var NoUnsafeFunctionTypeRule = rule.CreateRule(rule.Rule{
 	Name:             "no-unsafe-function-type",
 	Schema:           rule.EmptyArraySchema,
 	RequiresTypeInfo: true,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"bannedFunctionType"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		checkBannedType := func(ident *ast.Node) {
 			if ident == nil || ident.Kind != ast.KindIdentifier {
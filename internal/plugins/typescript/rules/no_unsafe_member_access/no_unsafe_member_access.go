@@ -84,6 +84,10 @@ var NoUnsafeMemberAccessRule = rule.CreateRule(rule.Rule{
 	Name:             "no-unsafe-member-access",
 	Schema:           rule.NewSchema(schemaJSON),
 	RequiresTypeInfo: true,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"unsafeComputedMemberAccess", "errorComputedMemberAccess", "unsafeMemberExpression", "errorMemberExpression", "unsafeThisMemberExpression", "errorThisMemberExpression"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 		compilerOptions := ctx.Program().Options()
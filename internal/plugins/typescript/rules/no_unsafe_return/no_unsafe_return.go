@@ -75,6 +75,10 @@ var NoUnsafeReturnRule = rule.CreateRule(rule.Rule{
 	Name:             "no-unsafe-return",
 	Schema:           rule.EmptyArraySchema,
 	RequiresTypeInfo: true,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"unsafeReturn", "unsafeReturnAssignment", "unsafeReturnThis"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		compilerOptions := ctx.Program().Options()
 		// When noImplicitThis is not enabled (considering strict mode), object literal methods
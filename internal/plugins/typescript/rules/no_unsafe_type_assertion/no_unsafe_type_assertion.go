@@ -55,6 +55,10 @@ var NoUnsafeTypeAssertionRule = rule.CreateRule(rule.Rule{
 	Name:             "no-unsafe-type-assertion",
 	Schema:           rule.EmptyArraySchema,
 	RequiresTypeInfo: true,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"unsafeOfAnyTypeAssertion", "unsafeToAnyTypeAssertion", "unsafeToUnconstrainedTypeAssertion", "unsafeTypeAssertion", "unsafeTypeAssertionAssignableToConstraint"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		checkExpression := func(node *ast.Node) {
 			expression := node.Expression()
@@ -20,6 +20,10 @@ var NoUnsafeUnaryMinusRule = rule.CreateRule(rule.Rule{
 	Name:             "no-unsafe-unary-minus",
 	Schema:           rule.EmptyArraySchema,
 	RequiresTypeInfo: true,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"unaryMinus"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindPrefixUnaryExpression: func(node *ast.Node) {
@@ -72,6 +72,10 @@ type thisAlias struct {
 var NoUnusedPrivateClassMembersRule = rule.CreateRule(rule.Rule{
 	Name:   "no-unused-private-class-members",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"unusedPrivateClassMember"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		// Root scope corresponds to upstream's IntermediateScope-for-Program:
 		// no `this` binding.
@@ -87,6 +87,10 @@ func parseOptions(rawOptions []any) options {
 var NoUseBeforeDefineRule = rule.CreateRule(rule.Rule{
 	Name:   "no-use-before-define",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"noUseBeforeDefine"},
+	},
 	Run: func(ctx rule.RuleContext, rawOptions []any) rule.RuleListeners {
 		opts := parseOptions(rawOptions)
 		if ctx.SourceFile == nil {
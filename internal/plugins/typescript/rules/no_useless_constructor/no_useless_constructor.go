@@ -298,6 +298,11 @@ func needsLeadingSemicolon(sourceFile *ast.SourceFile, classNode *ast.Node, node
 var NoUselessConstructorRule = rule.CreateRule(rule.Rule{
 	Name:   "no-useless-constructor",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:           rule.RuleTypeSuggestion,
+		HasSuggestions: true,
+		MessageIds:     []string{"noUselessConstructor", "removeConstructor"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindConstructor: func(node *ast.Node) {
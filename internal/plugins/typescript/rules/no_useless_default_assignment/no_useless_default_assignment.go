@@ -28,7 +28,12 @@ var NoUselessDefaultAssignmentRule = rule.CreateRule(rule.Rule{
 	Name:             "no-useless-default-assignment",
 	Schema:           rule.NewSchema(schemaJSON),
 	RequiresTypeInfo: true,
-	Run:              run,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"noStrictNullCheck", "preferOptionalSyntax", "uselessDefaultAssignment", "uselessUndefined"},
+	},
+	Run: run,
 })
 
 type Options struct {
@@ -285,6 +285,11 @@ func isDefinitionFile(fileName string) bool {
 var NoUselessEmptyExportRule = rule.CreateRule(rule.Rule{
 	Name:   "no-useless-empty-export",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"uselessExport"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		// In a definition file, export {} is necessary to make the module properly
 		// encapsulated, even when there are other exports
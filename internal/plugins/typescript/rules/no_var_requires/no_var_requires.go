@@ -34,6 +34,12 @@ func parseOptions(options []any) *Options {
 var NoVarRequiresRule = rule.CreateRule(rule.Rule{
 	Name:   "no-var-requires",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		Deprecated: true,
+		ReplacedBy: []string{"@typescript-eslint/no-require-imports"},
+		MessageIds: []string{"noVarReqs"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 
@@ -34,6 +34,11 @@ var NoWrapperObjectTypesRule = rule.CreateRule(rule.Rule{
 	Name:             "no-wrapper-object-types",
 	Schema:           rule.EmptyArraySchema,
 	RequiresTypeInfo: true,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"bannedClassType"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		// checkBannedTypes mirrors upstream's local `checkBannedTypes` —
 		// `node` is the inner Identifier the rule visits (TypeReference's
@@ -215,5 +215,10 @@ var NonNullableTypeAssertionStyleRule = rule.CreateRule(rule.Rule{
 	Name:             "non-nullable-type-assertion-style",
 	Schema:           rule.EmptyArraySchema,
 	RequiresTypeInfo: true,
-	Run:              runNonNullableTypeAssertionStyle,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"preferNonNullAssertion"},
+	},
+	Run: runNonNullableTypeAssertionStyle,
 })
@@ -127,6 +127,10 @@ type propertyNodes struct {
 var ParameterPropertiesRule = rule.CreateRule(rule.Rule{
 	Name:   "parameter-properties",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"preferClassProperty", "preferParameterProperty"},
+	},
 	Run: func(ctx rule.RuleContext, rawOptions []any) rule.RuleListeners {
 		opts := parseOptions(rawOptions)
 
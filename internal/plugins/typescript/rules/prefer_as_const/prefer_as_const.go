@@ -107,6 +107,12 @@ func compareLiteralTypes(ctx *rule.RuleContext, sourceText string, valueNode *as
 var PreferAsConstRule = rule.CreateRule(rule.Rule{
 	Name:   "prefer-as-const",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:           rule.RuleTypeSuggestion,
+		Fixable:        rule.FixableCode,
+		HasSuggestions: true,
+		MessageIds:     []string{"preferConstAssertion", "variableConstAssertion", "variableSuggest"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		compareTypes := func(valueNode *ast.Node, typeNode *ast.Node, canFix bool) {
 			if valueNode == nil || typeNode == nil ||
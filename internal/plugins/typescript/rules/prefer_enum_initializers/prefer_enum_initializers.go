@@ -27,6 +27,11 @@ func buildDefineInitializerSuggestionMessage(name, suggested string) rule.RuleMe
 var PreferEnumInitializersRule = rule.CreateRule(rule.Rule{
 	Name:   "prefer-enum-initializers",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:           rule.RuleTypeSuggestion,
+		HasSuggestions: true,
+		MessageIds:     []string{"defineInitializer", "defineInitializerSuggestion"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		sourceText := ctx.SourceFile.Text()
 		return rule.RuleListeners{
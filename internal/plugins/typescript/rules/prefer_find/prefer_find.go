@@ -49,6 +49,11 @@ var PreferFindRule = rule.CreateRule(rule.Rule{
 	Name:             "prefer-find",
 	Schema:           rule.EmptyArraySchema,
 	RequiresTypeInfo: true,
+	Meta: rule.RuleMeta{
+		Type:           rule.RuleTypeSuggestion,
+		HasSuggestions: true,
+		MessageIds:     []string{"preferFind", "preferFindSuggestion"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		if ctx.TypeChecker == nil {
 			return rule.RuleListeners{}
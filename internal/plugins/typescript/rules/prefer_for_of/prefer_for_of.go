@@ -9,6 +9,10 @@ import (
 var PreferForOfRule = rule.CreateRule(rule.Rule{
 	Name:   "prefer-for-of",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"preferForOf"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindForStatement: func(node *ast.Node) {
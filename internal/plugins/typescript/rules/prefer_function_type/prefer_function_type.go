@@ -44,7 +44,12 @@ func unexpectedThisMessage(interfaceName string) rule.RuleMessage {
 var PreferFunctionTypeRule = rule.CreateRule(rule.Rule{
 	Name:   "prefer-function-type",
 	Schema: rule.EmptyArraySchema,
-	Run:    run,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"functionTypeOverCallableType", "unexpectedThisOnFunctionOnlyInterface"},
+	},
+	Run: run,
 })
 
 // collectCommentsForward walks `text` starting at `start`, skipping whitespace
@@ -222,6 +222,11 @@ var PreferIncludesRule = rule.CreateRule(rule.Rule{
 	Name:             "prefer-includes",
 	Schema:           rule.EmptyArraySchema,
 	RequiresTypeInfo: true,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"preferIncludes", "preferStringIncludes"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		getNodeText := func(n *ast.Node) string {
 			if n == nil {
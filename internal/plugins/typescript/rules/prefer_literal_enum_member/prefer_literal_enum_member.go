@@ -27,6 +27,10 @@ func buildNotLiteralOrBitwiseExpressionMessage() rule.RuleMessage {
 var PreferLiteralEnumMemberRule = rule.CreateRule(rule.Rule{
 	Name:   "prefer-literal-enum-member",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"notLiteral", "notLiteralOrBitwiseExpression"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		allowBitwise := false
 		if len(options) > 0 {
@@ -39,6 +39,11 @@ func moduleDeclarationRanges(sourceFile *ast.SourceFile, node *ast.Node) (core.T
 var PreferNamespaceKeywordRule = rule.CreateRule(rule.Rule{
 	Name:   "prefer-namespace-keyword",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"useNamespace"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindModuleDeclaration: func(node *ast.Node) {
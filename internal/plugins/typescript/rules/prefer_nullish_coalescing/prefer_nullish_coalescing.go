@@ -1040,6 +1040,11 @@ var PreferNullishCoalescingRule = rule.CreateRule(rule.Rule{
 	Name:             "prefer-nullish-coalescing",
 	Schema:           rule.NewSchema(schemaJSON),
 	RequiresTypeInfo: true,
+	Meta: rule.RuleMeta{
+		Type:           rule.RuleTypeSuggestion,
+		HasSuggestions: true,
+		MessageIds:     []string{"noStrictNullCheck", "preferNullishOverAssignment", "preferNullishOverOr", "preferNullishOverTernary", "suggestNullish"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 		compilerOptions := ctx.Program().Options()
@@ -79,6 +79,12 @@ var PreferOptionalChainRule = rule.CreateRule(rule.Rule{
 	Name:             "prefer-optional-chain",
 	RequiresTypeInfo: true,
 	Schema:           rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:           rule.RuleTypeSuggestion,
+		Fixable:        rule.FixableCode,
+		HasSuggestions: true,
+		MessageIds:     []string{"preferOptionalChain", "optionalChainSuggest"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 
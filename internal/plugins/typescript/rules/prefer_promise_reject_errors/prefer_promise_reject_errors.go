@@ -47,6 +47,10 @@ var PreferPromiseRejectErrorsRule = rule.CreateRule(rule.Rule{
 	Name:             "prefer-promise-reject-errors",
 	Schema:           rule.NewSchema(schemaJSON),
 	RequiresTypeInfo: true,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"rejectAnError"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 
@@ -399,6 +399,11 @@ var PreferReadonlyRule = rule.CreateRule(rule.Rule{
 	Name:             "prefer-readonly",
 	Schema:           rule.NewSchema(schemaJSON),
 	RequiresTypeInfo: true,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"preferReadonly"},
+	},
 	Run: func(ctx rule.RuleContext, rawOpts []any) rule.RuleListeners {
 		if ctx.TypeChecker == nil {
 			return rule.RuleListeners{}
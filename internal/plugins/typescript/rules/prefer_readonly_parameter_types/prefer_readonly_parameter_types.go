@@ -134,6 +134,10 @@ var PreferReadonlyParameterTypesRule = rule.CreateRule(rule.Rule{
 	Name:             "prefer-readonly-parameter-types",
 	Schema:           rule.NewSchema(schemaJSON),
 	RequiresTypeInfo: true,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"shouldBeReadonly"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 
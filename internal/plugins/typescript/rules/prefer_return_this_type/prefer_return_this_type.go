@@ -17,6 +17,11 @@ var PreferReturnThisTypeRule = rule.CreateRule(rule.Rule{
 	Name:             "prefer-return-this-type",
 	Schema:           rule.EmptyArraySchema,
 	RequiresTypeInfo: true,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"useThisType"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		var tryGetNameInTypeNode func(name string, node *ast.Node) *ast.Node
 		tryGetNameInTypeNode = func(name string, node *ast.Node) *ast.Node {
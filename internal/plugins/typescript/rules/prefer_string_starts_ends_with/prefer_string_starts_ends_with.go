@@ -426,6 +426,11 @@ var PreferStringStartsEndsWithRule = rule.CreateRule(rule.Rule{
 	Name:             "prefer-string-starts-ends-with",
 	Schema:           rule.NewSchema(schemaJSON),
 	RequiresTypeInfo: true,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"preferStartsWith", "preferEndsWith"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 
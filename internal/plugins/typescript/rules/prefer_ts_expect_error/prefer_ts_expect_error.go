@@ -108,6 +108,13 @@ func findTsIgnoreDirective(commentText string, kind ast.Kind) (int, int, bool) {
 var PreferTsExpectErrorRule = rule.CreateRule(rule.Rule{
 	Name:   "prefer-ts-expect-error",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		Fixable:    rule.FixableCode,
+		Deprecated: true,
+		ReplacedBy: []string{"@typescript-eslint/ban-ts-comment"},
+		MessageIds: []string{"preferExpectErrorComment"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		fullText := ctx.SourceFile.Text()
 		if !strings.Contains(fullText, tsIgnoreDirective) {
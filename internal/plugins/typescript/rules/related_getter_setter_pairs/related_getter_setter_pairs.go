@@ -18,6 +18,10 @@ var RelatedGetterSetterPairsRule = rule.CreateRule(rule.Rule{
 	Name:             "related-getter-setter-pairs",
 	Schema:           rule.EmptyArraySchema,
 	RequiresTypeInfo: true,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"mismatch"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		checkAccessorsPair := func(getter *ast.GetAccessorDeclaration, setter *ast.SetAccessorDeclaration) {
 			getType := ctx.TypeChecker.GetTypeAtLocation(getter.AsNode())
@@ -41,6 +41,10 @@ var RequireArraySortCompareRule = rule.CreateRule(rule.Rule{
 	Name:             "require-array-sort-compare",
 	Schema:           rule.NewSchema(schemaJSON),
 	RequiresTypeInfo: true,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"requireCompare"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 
@@ -294,6 +294,10 @@ var RequireAwaitRule = rule.CreateRule(rule.Rule{
 	Name:             "require-await",
 	Schema:           rule.EmptyArraySchema,
 	RequiresTypeInfo: true,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"missingAwait", "removeAsync"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		var scopes []scopeInfo
 
@@ -221,6 +221,10 @@ var RestrictTemplateExpressionsRule = rule.CreateRule(rule.Rule{
 	Name:             "restrict-template-expressions",
 	RequiresTypeInfo: true,
 	Schema:           rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"invalidType"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		typeChecker := templateExpressionTypeChecker{
 			typeChecker: ctx.TypeChecker,
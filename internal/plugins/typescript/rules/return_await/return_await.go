@@ -157,6 +157,12 @@ var ReturnAwaitRule = rule.CreateRule(rule.Rule{
 	Name:             "return-await",
 	Schema:           rule.NewSchema(schemaJSON),
 	RequiresTypeInfo: true,
+	Meta: rule.RuleMeta{
+		Type:           rule.RuleTypeProblem,
+		Fixable:        rule.FixableCode,
+		HasSuggestions: true,
+		MessageIds:     []string{"disallowedPromiseAwait", "disallowedPromiseAwaitSuggestion", "nonPromiseAwait", "requiredPromiseAwait", "requiredPromiseAwaitSuggestion"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		option := parseReturnAwaitOption(options)
 		sourceFile := ctx.SourceFile
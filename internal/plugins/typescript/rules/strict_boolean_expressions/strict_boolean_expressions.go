@@ -254,6 +254,11 @@ var StrictBooleanExpressionsRule = rule.CreateRule(rule.Rule{
 	Name:             "strict-boolean-expressions",
 	Schema:           rule.NewSchema(schemaJSON),
 	RequiresTypeInfo: true,
+	Meta: rule.RuleMeta{
+		Type:           rule.RuleTypeSuggestion,
+		HasSuggestions: true,
+		MessageIds:     []string{"conditionErrorAny", "conditionErrorNullableBoolean", "conditionErrorNullableEnum", "conditionErrorNullableNumber", "conditionErrorNullableObject", "conditionErrorNullableString", "conditionErrorNullish", "conditionErrorNumber", "conditionErrorObject", "conditionErrorOther", "conditionErrorString", "noStrictNullCheck", "predicateCannotBeAsync", "explicitBooleanReturnType", "conditionFixCastBoolean", "conditionFixCompareArrayLengthNonzero", "conditionFixCompareArrayLengthZero", "conditionFixCompareEmptyString", "conditionFixCompareFalse", "conditionFixCompareNaN", "conditionFixCompareNullish", "conditionFixCompareStringLength", "conditionFixCompareTrue", "conditionFixCompareZero", "conditionFixDefaultEmptyString", "conditionFixDefaultFalse", "conditionFixDefaultZero"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 		tc := ctx.TypeChecker
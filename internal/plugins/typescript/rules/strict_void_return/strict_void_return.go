@@ -54,6 +54,10 @@ var StrictVoidReturnRule = rule.CreateRule(rule.Rule{
 	Name:             "strict-void-return",
 	Schema:           rule.NewSchema(schemaJSON),
 	RequiresTypeInfo: true,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"asyncFunc", "nonVoidFunc", "nonVoidReturn"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 
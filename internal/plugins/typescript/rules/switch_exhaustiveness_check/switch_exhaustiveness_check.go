@@ -31,7 +31,12 @@ var SwitchExhaustivenessCheckRule = rule.CreateRule(rule.Rule{
 	Name:             "switch-exhaustiveness-check",
 	Schema:           rule.NewSchema(schemaJSON),
 	RequiresTypeInfo: true,
-	Run:              run,
+	Meta: rule.RuleMeta{
+		Type:           rule.RuleTypeSuggestion,
+		HasSuggestions: true,
+		MessageIds:     []string{"switchIsNotExhaustive", "addMissingCases", "dangerousDefaultCase"},
+	},
+	Run: run,
 })
 
 // defaultCommentPattern mirrors upstream's DEFAULT_COMMENT_PATTERN
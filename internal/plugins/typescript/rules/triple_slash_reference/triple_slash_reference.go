@@ -52,7 +52,11 @@ var tripleSlashReferenceCommentFactory = ast.NewNodeFactory(ast.NodeFactoryHooks
 var TripleSlashReferenceRule = rule.CreateRule(rule.Rule{
 	Name:   "triple-slash-reference",
 	Schema: rule.NewSchema(schemaJSON),
-	Run:    run,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"tripleSlashReference"},
+	},
+	Run: run,
 })
 
 func run(ctx rule.RuleContext, options []any) rule.RuleListeners {
@@ -210,6 +210,10 @@ var UnboundMethodRule = rule.CreateRule(rule.Rule{
 	Name:             "unbound-method",
 	Schema:           rule.NewSchema(schemaJSON),
 	RequiresTypeInfo: true,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"unbound", "unboundWithoutThisAnnotation"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 
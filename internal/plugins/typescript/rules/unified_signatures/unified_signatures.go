@@ -18,6 +18,9 @@ type UnifiedSignaturesOptions struct {
 var UnifiedSignaturesRule = rule.CreateRule(rule.Rule{
 	Name:   "unified-signatures",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type: rule.RuleTypeSuggestion,
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := UnifiedSignaturesOptions{
 			IgnoreDifferentlyNamedParameters:  false,
@@ -60,6 +60,11 @@ var UseUnknownInCatchCallbackVariableRule = rule.CreateRule(rule.Rule{
 	Name:             "use-unknown-in-catch-callback-variable",
 	Schema:           rule.EmptyArraySchema,
 	RequiresTypeInfo: true,
+	Meta: rule.RuleMeta{
+		Type:           rule.RuleTypeSuggestion,
+		HasSuggestions: true,
+		MessageIds:     []string{"addUnknownRestTypeAnnotationSuggestion", "addUnknownTypeAnnotationSuggestion", "useUnknown", "useUnknownArrayDestructuringPattern", "useUnknownObjectDestructuringPattern", "wrongRestTypeAnnotationSuggestion", "wrongTypeAnnotationSuggestion"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		var collectFlaggedNodes func(node *ast.Node) []*ast.Node
 
@@ -68,6 +68,9 @@ func getMessageArgumentIndex(constructorName string) int {
 var ErrorMessageRule = rule.Rule{
 	Name:   "unicorn/error-message",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type: rule.RuleTypeProblem,
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		staticStrings := utils.NewStaticStringEvaluatorWithReferenceResolver(ctx.TypeChecker, ctx.SourceFile, ctx.Refs)
 
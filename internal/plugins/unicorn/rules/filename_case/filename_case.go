@@ -709,6 +709,10 @@ var FilenameCaseRule = rule.Rule{
 	// return an empty listener map. (The linter's visitor walks SourceFile
 	// children but never the SourceFile node itself, so a
 	// `ast.KindSourceFile` listener would silently never fire.)
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"invalidIgnorePattern", "directoryCase", "filenameExtension", "filenameCase"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		if ctx.SourceFile == nil {
 			return nil
@@ -26,6 +26,11 @@ const (
 var NewForBuiltinsRule = rule.Rule{
 	Name:   "unicorn/new-for-builtins",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:           rule.RuleTypeSuggestion,
+		Fixable:        rule.FixableCode,
+		HasSuggestions: true,
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		if !sourceHasPotentialReference(ctx.SourceFile) {
 			return nil
@@ -52,6 +52,10 @@ func removeAwaitSuggestion(sourceFile *ast.SourceFile, awaitExpression *ast.Node
 var NoAwaitInPromiseMethodsRule = rule.Rule{
 	Name:   "unicorn/no-await-in-promise-methods",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:           rule.RuleTypeProblem,
+		HasSuggestions: true,
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		oneArgument := 1
 
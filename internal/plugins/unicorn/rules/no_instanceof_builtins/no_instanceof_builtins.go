@@ -83,6 +83,11 @@ type options struct {
 var NoInstanceofBuiltinsRule = rule.Rule{
 	Name:   "unicorn/no-instanceof-builtins",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:           rule.RuleTypeProblem,
+		Fixable:        rule.FixableCode,
+		HasSuggestions: true,
+	},
 	Run: func(ctx rule.RuleContext, rawOptions []any) rule.RuleListeners {
 		opts := parseOptions(rawOptions)
 
@@ -18,6 +18,9 @@ var invalidListenerMessage = rule.RuleMessage{
 var NoInvalidRemoveEventListenerRule = rule.Rule{
 	Name:   "unicorn/no-invalid-remove-event-listener",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type: rule.RuleTypeProblem,
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		minimumArguments := 2
 
@@ -519,6 +519,11 @@ func getHeritageClauses(node *ast.Node) *ast.NodeList {
 var NoStaticOnlyClassRule = rule.Rule{
 	Name:   "unicorn/no-static-only-class",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"noStaticOnlyClass"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		check := func(node *ast.Node) {
 			// Skip classes that extend another class — the static members
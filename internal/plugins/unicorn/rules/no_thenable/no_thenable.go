@@ -32,6 +32,9 @@ var (
 var NoThenableRule = rule.Rule{
 	Name:   "unicorn/no-thenable",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type: rule.RuleTypeProblem,
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		state := &ruleState{ctx: ctx}
 
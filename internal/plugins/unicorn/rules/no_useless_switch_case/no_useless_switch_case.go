@@ -15,6 +15,10 @@ const (
 var NoUselessSwitchCaseRule = rule.Rule{
 	Name:   "unicorn/no-useless-switch-case",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:           rule.RuleTypeSuggestion,
+		HasSuggestions: true,
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindSwitchStatement: func(node *ast.Node) {
@@ -374,6 +374,10 @@ func parseFunctions(options []any) []string {
 var PreferArrayFlatRule = rule.Rule{
 	Name:   "unicorn/prefer-array-flat",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:    rule.RuleTypeSuggestion,
+		Fixable: rule.FixableCode,
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		functions := parseFunctions(options)
 		return rule.RuleListeners{
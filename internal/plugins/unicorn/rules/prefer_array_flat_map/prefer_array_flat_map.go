@@ -67,6 +67,10 @@ func buildFixes(sf *ast.SourceFile, flatCall dotMethodCall, mapCall dotMethodCal
 var PreferArrayFlatMapRule = rule.Rule{
 	Name:   "unicorn/prefer-array-flat-map",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:    rule.RuleTypeSuggestion,
+		Fixable: rule.FixableCode,
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindCallExpression: func(node *ast.Node) {
@@ -49,6 +49,11 @@ func messageArrayFilter() rule.RuleMessage {
 var PreferArraySomeRule = rule.Rule{
 	Name:   "unicorn/prefer-array-some",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:           rule.RuleTypeSuggestion,
+		Fixable:        rule.FixableCode,
+		HasSuggestions: true,
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			// `.find(…)` / `.findLast(…)` used in a boolean position.
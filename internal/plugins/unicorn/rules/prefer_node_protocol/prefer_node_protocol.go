@@ -32,6 +32,10 @@ func message(moduleName string) rule.RuleMessage {
 var PreferNodeProtocolRule = rule.Rule{
 	Name:   "unicorn/prefer-node-protocol",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:    rule.RuleTypeSuggestion,
+		Fixable: rule.FixableCode,
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		// check inspects a string-literal module specifier and reports + fixes
 		// it when it names a Node.js builtin missing the `node:` prefix.
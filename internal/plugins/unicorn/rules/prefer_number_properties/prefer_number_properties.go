@@ -36,6 +36,11 @@ type globalReference struct {
 var PreferNumberPropertiesRule = rule.Rule{
 	Name:   "unicorn/prefer-number-properties",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:           rule.RuleTypeSuggestion,
+		Fixable:        rule.FixableCode,
+		HasSuggestions: true,
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 
@@ -97,6 +97,11 @@ func messageSuggestion(name string) rule.RuleMessage {
 var PreferSetHasRule = rule.Rule{
 	Name:   "unicorn/prefer-set-has",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:           rule.RuleTypeSuggestion,
+		Fixable:        rule.FixableCode,
+		HasSuggestions: true,
+	},
 	Run: func(ctx rule.RuleContext, rawOptions []any) rule.RuleListeners {
 		opts := parseOptions(rawOptions)
 
@@ -83,6 +83,10 @@ func appendSeparatorFix(closing core.TextRange, penultimateKind ast.Kind, isProt
 var RequireArrayJoinSeparatorRule = rule.Rule{
 	Name:   "unicorn/require-array-join-separator",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:    rule.RuleTypeSuggestion,
+		Fixable: rule.FixableCode,
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		zeroArguments := 0
 		oneArgument := 1
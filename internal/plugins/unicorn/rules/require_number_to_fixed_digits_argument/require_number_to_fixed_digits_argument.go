@@ -57,6 +57,10 @@ func callParenthesesRange(sourceFile *ast.SourceFile, node *ast.Node) (core.Text
 var RequireNumberToFixedDigitsArgumentRule = rule.Rule{
 	Name:   "unicorn/require-number-to-fixed-digits-argument",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:    rule.RuleTypeSuggestion,
+		Fixable: rule.FixableCode,
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		zeroArguments := 0
 		return rule.RuleListeners{
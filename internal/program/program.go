@@ -35,6 +35,7 @@ type Program struct {
 type sourceBackend interface {
 	sourceFiles() []*ast.SourceFile
 	rootFileNames() []string
+	configFileNames() []string
 	ownsSourceFile(file *ast.SourceFile) bool
 	compilerOptions() *core.CompilerOptions
 	fileSystem() vfs.FS
@@ -111,6 +112,15 @@ func (p *Program) RootFileNames() []string {
 	return p.source.rootFileNames()
 }
 
+// ConfigFileNames returns the tsconfig that defines the Program followed by
+// every config it extends. A Program built from plain roots has none.
+func (p *Program) ConfigFileNames() []string {
+	if !p.IsValid() {
+		return nil
+	}
+	return p.source.configFileNames()
+}
+
 // OwnsSourceFile reports whether file is the exact AST generation exposed by
 // this Program.
 func (p *Program) OwnsSourceFile(file *ast.SourceFile) bool {
@@ -362,6 +372,13 @@ func packageNamesFromResolutions(
 
 func (b *compilerBackend) sourceFiles() []*ast.SourceFile { return b.raw.SourceFiles() }
 func (b *compilerBackend) rootFileNames() []string        { return b.raw.CommandLine().FileNames() }
+func (b *compilerBackend) configFileNames() []string {
+	commandLine := b.raw.CommandLine()
+	if commandLine.ConfigName() == "" {
+		return nil
+	}
+	return append([]string{commandLine.ConfigName()}, commandLine.ExtendedSourceFiles()...)
+}
 func (b *compilerBackend) ownsSourceFile(file *ast.SourceFile) bool {
 	return b.raw.GetSourceFile(file.FileName()) == file
 }
@@ -425,6 +442,7 @@ func (s *parsedBackend) rootFileNames() []string {
 	}
 	return roots
 }
+func (s *parsedBackend) configFileNames() []string { return nil }
 func (s *parsedBackend) ownsSourceFile(file *ast.SourceFile) bool {
 	return s.sourcesByPath[file.Path()] == file
 }
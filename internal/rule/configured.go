@@ -29,6 +29,26 @@ type RuleEnvironment struct {
 	// Globals contains config-declared language globals. Inline declarations
 	// are merged once per source file during execution.
 	Globals map[string]utils.GlobalAccess
+	// LinterOptions is the effective flat-config `linterOptions` object.
+	LinterOptions LinterOptions
+	// InlineRules resolves `/* eslint name: value */` comments against the
+	// same configuration. Nil disables inline rule configuration.
+	InlineRules InlineRuleResolver
+}
+
+// LinterOptions controls how inline configuration comments are treated in a
+// file. Each Report flag is paired with the severity it reports at, so the
+// zero value means "inline config allowed, nothing reported".
+type LinterOptions struct {
+	// NoInlineConfig ignores disable/enable directives and `/* global */`
+	// comments.
+	NoInlineConfig bool
+
+	ReportUnusedDisableDirectives  bool
+	UnusedDisableDirectiveSeverity DiagnosticSeverity
+
+	ReportUnusedInlineConfigs  bool
+	UnusedInlineConfigSeverity DiagnosticSeverity
 }
 
 // FilterNonTypeAwareRules returns the entries that do not require a checker.
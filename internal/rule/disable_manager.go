@@ -1,9 +1,12 @@
 package rule
 
 import (
+	"fmt"
 	"strings"
+	"unicode"
 
 	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/core"
 	"github.com/microsoft/typescript-go/shim/scanner"
 )
 
@@ -12,6 +15,52 @@ type blockDirective struct {
 	line      int
 	isDisable bool     // true = disable, false = enable
 	rules     []string // nil means all rules (wildcard)
+	// directive is the parsed disable comment, nil for enable events.
+	directive *disableDirective
+}
+
+// disableDirective is one disable comment whose use is tracked so that
+// directives which suppressed nothing can be reported.
+type disableDirective struct {
+	pos, end int    // comment range
+	name     string // e.g. "eslint-disable-next-line"
+	rules    []directiveRule
+	used     bool // wildcard directives only
+}
+
+// directiveRule is a rule name listed in a disable directive, with its
+// position in the source text.
+type directiveRule struct {
+	name     string
+	pos, end int
+	used     bool
+}
+
+// markUsed credits a suppressed diagnostic to the directive. ruleIndex is -1
+// for a wildcard directive.
+func (d *disableDirective) markUsed(ruleIndex int) {
+	if d == nil {
+		return
+	}
+	if ruleIndex < 0 {
+		d.used = true
+		return
+	}
+	d.rules[ruleIndex].used = true
+}
+
+// ruleIndex returns the index of ruleName in the directive's rule list, -1 for
+// a wildcard directive, and ok=false when the directive does not cover it.
+func (d *disableDirective) ruleIndex(ruleName string) (int, bool) {
+	if d.rules == nil {
+		return -1, true
+	}
+	for i, r := range d.rules {
+		if r.name == ruleName {
+			return i, true
+		}
+	}
+	return 0, false
 }
 
 // directiveKind represents the type of an inline directive comment.
@@ -46,6 +95,14 @@ type DisableManager struct {
 	blockDirectives       []blockDirective // block disable/enable events in source order
 	lineDisabledRules     map[int][]string // Rules disabled for specific lines
 	nextLineDisabledRules map[int][]string // Rules disabled for the next line
+	// disableDirectives holds every disable comment in source order;
+	// lineScopedDirectives indexes the -line and -next-line ones by the line
+	// they suppress.
+	disableDirectives    []*disableDirective
+	lineScopedDirectives map[int][]*disableDirective
+	// ignoreDirectives is set for files whose linterOptions.noInlineConfig is
+	// on: no directive suppresses anything.
+	ignoreDirectives bool
 }
 
 // NewDisableManager creates a manager whose directives are parsed on the first
@@ -57,6 +114,14 @@ func NewDisableManager(sourceFile *ast.SourceFile, comments *CommentStore) *Disa
 	}
 }
 
+// IgnoreDirectives makes the manager behave as if the file had no directive
+// comments.
+func (dm *DisableManager) IgnoreDirectives() {
+	if dm != nil {
+		dm.ignoreDirectives = true
+	}
+}
+
 func (dm *DisableManager) ensureParsed() {
 	if dm == nil || dm.parsed {
 		return
@@ -99,23 +164,34 @@ func (dm *DisableManager) parseDirectives(comments []*ast.CommentRange) {
 	text := dm.sourceFile.Text()
 
 	for _, comment := range comments {
-		var commentContent string
-		switch comment.Kind {
-		case ast.KindSingleLineCommentTrivia:
-			commentContent = strings.TrimSpace(text[comment.Pos()+2 : comment.End()])
-		case ast.KindMultiLineCommentTrivia:
-			commentContent = strings.TrimSpace(text[comment.Pos()+2 : comment.End()-2])
-		default:
+		commentContent, contentStart, ok := directiveCommentContent(text, comment)
+		if !ok {
 			continue
 		}
 
-		kind, rules := matchDirective(commentContent)
+		kind, nameLength := matchDirectiveName(commentContent)
 		if kind == directiveNone {
 			continue
 		}
+		spans := parseRuleSpans(commentContent[nameLength:], contentStart+nameLength)
+		var rules []string
+		for _, span := range spans {
+			rules = append(rules, span.name)
+		}
 
 		lineNum, _ := scanner.GetECMALineAndUTF16CharacterOfPosition(dm.sourceFile, comment.Pos())
 
+		var directive *disableDirective
+		if kind != directiveEnable {
+			directive = &disableDirective{
+				pos:   comment.Pos(),
+				end:   comment.End(),
+				name:  commentContent[:nameLength],
+				rules: spans,
+			}
+			dm.disableDirectives = append(dm.disableDirectives, directive)
+		}
+
 		switch kind {
 		case directiveLine:
 			if dm.lineDisabledRules == nil {
@@ -126,6 +202,7 @@ func (dm *DisableManager) parseDirectives(comments []*ast.CommentRange) {
 			} else {
 				dm.lineDisabledRules[lineNum] = append(dm.lineDisabledRules[lineNum], rules...)
 			}
+			dm.addLineScopedDirective(lineNum, directive)
 		case directiveNextLine:
 			nextLineNum := lineNum + 1
 			if dm.nextLineDisabledRules == nil {
@@ -136,11 +213,13 @@ func (dm *DisableManager) parseDirectives(comments []*ast.CommentRange) {
 			} else {
 				dm.nextLineDisabledRules[nextLineNum] = append(dm.nextLineDisabledRules[nextLineNum], rules...)
 			}
+			dm.addLineScopedDirective(nextLineNum, directive)
 		case directiveBlock:
 			dm.blockDirectives = append(dm.blockDirectives, blockDirective{
 				line:      lineNum,
 				isDisable: true,
 				rules:     rules,
+				directive: directive,
 			})
 		case directiveEnable:
 			dm.blockDirectives = append(dm.blockDirectives, blockDirective{
@@ -152,54 +231,103 @@ func (dm *DisableManager) parseDirectives(comments []*ast.CommentRange) {
 	}
 }
 
+// directiveCommentContent returns a comment's text without its delimiters and
+// surrounding whitespace, and the source position where that text starts.
+func directiveCommentContent(text string, comment *ast.CommentRange) (string, int, bool) {
+	contentStart := comment.Pos() + 2
+	var rawContent string
+	switch comment.Kind {
+	case ast.KindSingleLineCommentTrivia:
+		rawContent = text[contentStart:comment.End()]
+	case ast.KindMultiLineCommentTrivia:
+		rawContent = text[contentStart : comment.End()-2]
+	default:
+		return "", 0, false
+	}
+	contentStart += len(rawContent) - len(strings.TrimLeftFunc(rawContent, unicode.IsSpace))
+	return strings.TrimSpace(rawContent), contentStart, true
+}
+
+func (dm *DisableManager) addLineScopedDirective(line int, directive *disableDirective) {
+	if dm.lineScopedDirectives == nil {
+		dm.lineScopedDirectives = make(map[int][]*disableDirective)
+	}
+	dm.lineScopedDirectives[line] = append(dm.lineScopedDirectives[line], directive)
+}
+
 // matchDirective checks if a comment content string is a disable/enable directive.
 // Returns the directive kind and any specified rule names.
 func matchDirective(commentContent string) (directiveKind, []string) {
+	kind, nameLength := matchDirectiveName(commentContent)
+	if kind == directiveNone {
+		return directiveNone, nil
+	}
+	return kind, parseRuleNames(commentContent[nameLength:])
+}
+
+// matchDirectiveName returns the directive kind of a comment and the length of
+// its directive name, e.g. len("eslint-disable-line").
+func matchDirectiveName(commentContent string) (directiveKind, int) {
 	for _, p := range directivePrefixes {
 		if strings.HasPrefix(commentContent, p.disable) {
 			rest := commentContent[len(p.disable):]
 			if strings.HasPrefix(rest, "-line") {
-				return directiveLine, parseRuleNames(rest[len("-line"):])
+				return directiveLine, len(p.disable) + len("-line")
 			}
 			if strings.HasPrefix(rest, "-next-line") {
-				return directiveNextLine, parseRuleNames(rest[len("-next-line"):])
+				return directiveNextLine, len(p.disable) + len("-next-line")
 			}
-			return directiveBlock, parseRuleNames(rest)
+			return directiveBlock, len(p.disable)
 		}
 		if strings.HasPrefix(commentContent, p.enable) {
-			return directiveEnable, parseRuleNames(commentContent[len(p.enable):])
+			return directiveEnable, len(p.enable)
 		}
 	}
-	return directiveNone, nil
+	return directiveNone, 0
 }
 
 // parseRuleNames parses rule names from a string like " rule1, rule2, rule3"
 // It also strips inline descriptions after " -- " (e.g., "rule1 -- reason")
 func parseRuleNames(rulesStr string) []string {
+	var rules []string
+	for _, span := range parseRuleSpans(rulesStr, 0) {
+		rules = append(rules, span.name)
+	}
+	return rules
+}
+
+// parseRuleSpans is parseRuleNames keeping each name's offset, where offset
+// is the source position of rulesStr.
+func parseRuleSpans(rulesStr string, offset int) []directiveRule {
 	// Strip inline description after " -- " before trimming, so that
 	// wildcard-with-description like " -- reason" is correctly handled.
 	if idx := strings.Index(rulesStr, " -- "); idx != -1 {
 		rulesStr = rulesStr[:idx]
 	}
 
-	rulesStr = strings.TrimSpace(rulesStr)
-	if rulesStr == "" {
-		return nil
-	}
-
-	var rules []string
-	for _, rule := range strings.Split(rulesStr, ",") {
-		rule = strings.TrimSpace(rule)
-		if rule != "" {
-			rules = append(rules, rule)
+	var rules []directiveRule
+	for start := 0; start <= len(rulesStr); {
+		end := strings.IndexByte(rulesStr[start:], ',')
+		if end < 0 {
+			end = len(rulesStr)
+		} else {
+			end += start
 		}
+		part := rulesStr[start:end]
+		if name := strings.TrimSpace(part); name != "" {
+			pos := offset + start + strings.Index(part, name)
+			rules = append(rules, directiveRule{name: name, pos: pos, end: pos + len(name)})
+		}
+		start = end + 1
 	}
 	return rules
 }
 
-// IsRuleDisabled checks if a rule is disabled at the given position
+// IsRuleDisabled checks if a rule is disabled at the given position.
+// Callers ask right before reporting, so a true result also credits the
+// suppressing directive as used.
 func (dm *DisableManager) IsRuleDisabled(ruleName string, pos int) bool {
-	if dm == nil || dm.sourceFile == nil {
+	if dm == nil || dm.sourceFile == nil || dm.ignoreDirectives {
 		return false
 	}
 	dm.ensureParsed()
@@ -209,11 +337,23 @@ func (dm *DisableManager) IsRuleDisabled(ruleName string, pos int) bool {
 
 	line, _ := scanner.GetECMALineAndUTF16CharacterOfPosition(dm.sourceFile, pos)
 
+	// Check line-scoped directives first: when a block range also covers the
+	// line, the closer directive is the one credited with the suppression.
+	if dm.isLineScopedDisabled(ruleName, line) {
+		dm.markLineScopedUsed(ruleName, line)
+		return true
+	}
+
 	// Check block disable/enable directives (range-based)
-	if dm.isBlockDisabled(ruleName, line) {
+	if disabled, directive, ruleIndex := dm.blockSuppression(ruleName, line); disabled {
+		directive.markUsed(ruleIndex)
 		return true
 	}
 
+	return false
+}
+
+func (dm *DisableManager) isLineScopedDisabled(ruleName string, line int) bool {
 	// Check if rule is disabled for this specific line
 	if lineRules, exists := dm.lineDisabledRules[line]; exists {
 		for _, disabledRule := range lineRules {
@@ -235,12 +375,33 @@ func (dm *DisableManager) IsRuleDisabled(ruleName string, pos int) bool {
 	return false
 }
 
+// markLineScopedUsed credits the last line-scoped directive for line that
+// covers ruleName.
+func (dm *DisableManager) markLineScopedUsed(ruleName string, line int) {
+	directives := dm.lineScopedDirectives[line]
+	for i := len(directives) - 1; i >= 0; i-- {
+		if ruleIndex, ok := directives[i].ruleIndex(ruleName); ok {
+			directives[i].markUsed(ruleIndex)
+			return
+		}
+	}
+}
+
 // isBlockDisabled replays block directives in source order to determine
 // whether a rule is disabled at the given line.
 func (dm *DisableManager) isBlockDisabled(ruleName string, line int) bool {
+	disabled, _, _ := dm.blockSuppression(ruleName, line)
+	return disabled
+}
+
+// blockSuppression is isBlockDisabled that also returns the disable directive
+// in effect and the index of ruleName in it (-1 for a wildcard).
+func (dm *DisableManager) blockSuppression(ruleName string, line int) (bool, *disableDirective, int) {
 	allDisabled := false
 	ruleDisabled := false
 	hasRuleSpecific := false
+	var allDirective, ruleDirective *disableDirective
+	ruleIndex := 0
 
 	for _, d := range dm.blockDirectives {
 		if d.line > line {
@@ -250,11 +411,14 @@ func (dm *DisableManager) isBlockDisabled(ruleName string, line int) bool {
 		if len(d.rules) == 0 {
 			// Wildcard directive: affects all rules and resets rule-specific state
 			allDisabled = d.isDisable
+			allDirective = d.directive
 			hasRuleSpecific = false
 		} else {
-			for _, r := range d.rules {
+			for i, r := range d.rules {
 				if r == ruleName {
 					ruleDisabled = d.isDisable
+					ruleDirective = d.directive
+					ruleIndex = i
 					hasRuleSpecific = true
 				}
 			}
@@ -262,7 +426,150 @@ func (dm *DisableManager) isBlockDisabled(ruleName string, line int) bool {
 	}
 
 	if hasRuleSpecific {
-		return ruleDisabled
+		return ruleDisabled, ruleDirective, ruleIndex
+	}
+	return allDisabled, allDirective, -1
+}
+
+// UnusedDisableDirectiveRuleName is the rule name carried by diagnostics for
+// disable directives that suppressed nothing. It is not a registered rule.
+const UnusedDisableDirectiveRuleName = "unused-disable-directive"
+
+// UnusedDirectiveDiagnostics reports the disable directives, and the rule
+// names within them, that suppressed no diagnostic. It must run after every
+// rule has reported for the file.
+//
+// A rule name is only reported when isChecked says that rule ran on this
+// file: a name whose rule did not run (turned off, or executed elsewhere such
+// as an ESLint plugin rule) cannot be judged. For the same reason wildcard
+// directives are only reported when includeWildcards is set.
+//
+// Each diagnostic carries a fix that deletes the whole comment, or only the
+// stale name when other names in the same directive are still in use.
+func (dm *DisableManager) UnusedDirectiveDiagnostics(severity DiagnosticSeverity, isChecked func(ruleName string) bool, includeWildcards bool) []RuleDiagnostic {
+	if dm == nil || dm.sourceFile == nil || dm.ignoreDirectives {
+		return nil
+	}
+	dm.ensureParsed()
+
+	var diagnostics []RuleDiagnostic
+	report := func(textRange core.TextRange, description string, fix RuleFix) {
+		diagnostics = append(diagnostics, RuleDiagnostic{
+			Range:    textRange,
+			RuleName: UnusedDisableDirectiveRuleName,
+			Message: RuleMessage{
+				Id:          "unusedDisableDirective",
+				Description: description,
+			},
+			FixesPtr:   &[]RuleFix{fix},
+			SourceFile: dm.sourceFile,
+			FilePath:   dm.sourceFile.FileName(),
+			Severity:   severity,
+		})
+	}
+	text := dm.sourceFile.Text()
+	// Like ESLint, messages name the -line and -next-line forms after their
+	// block form.
+	prefix := func(d *disableDirective) string {
+		return strings.TrimSuffix(strings.TrimSuffix(d.name, "-next-line"), "-line")
+	}
+
+	for _, d := range dm.disableDirectives {
+		commentRange := core.NewTextRange(d.pos, d.end)
+		if d.rules == nil {
+			if includeWildcards && !d.used {
+				report(commentRange,
+					fmt.Sprintf("Unused %s directive (no problems were reported).", prefix(d)),
+					commentRemovalFix(text, d.pos, d.end))
+			}
+			continue
+		}
+
+		var unused []int
+		for i, r := range d.rules {
+			if !r.used && isChecked(r.name) {
+				unused = append(unused, i)
+			}
+		}
+		if len(unused) == 0 {
+			continue
+		}
+		if len(unused) == len(d.rules) {
+			names := make([]string, len(d.rules))
+			for i, r := range d.rules {
+				names[i] = r.name
+			}
+			report(commentRange,
+				fmt.Sprintf("Unused %s directive (no problems were reported from %s).", prefix(d), quotedList(names)),
+				commentRemovalFix(text, d.pos, d.end))
+			continue
+		}
+		for _, i := range unused {
+			r := d.rules[i]
+			// Take the separating comma with the name: the one before it, or
+			// the one after it when the name comes first.
+			var removal core.TextRange
+			if i > 0 {
+				removal = core.NewTextRange(d.rules[i-1].end, r.end)
+			} else {
+				removal = core.NewTextRange(r.pos, d.rules[i+1].pos)
+			}
+			report(core.NewTextRange(r.pos, r.end),
+				fmt.Sprintf("Unused %s directive (no problems were reported from '%s').", prefix(d), r.name),
+				RuleFixRemoveRange(removal))
+		}
+	}
+	return diagnostics
+}
+
+// quotedList formats names the way ESLint lists them: 'a', 'a' or 'b', and
+// 'a', 'b', or 'c'.
+func quotedList(names []string) string {
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = "'" + name + "'"
+	}
+	switch len(quoted) {
+	case 1:
+		return quoted[0]
+	case 2:
+		return quoted[0] + " or " + quoted[1]
+	default:
+		return strings.Join(quoted[:len(quoted)-1], ", ") + ", or " + quoted[len(quoted)-1]
+	}
+}
+
+// commentRemovalFix deletes the comment at [pos, end). A comment alone on its
+// line takes the line with it, a trailing comment takes the whitespace before
+// it, and a comment between two tokens leaves a single space so they do not
+// run together.
+func commentRemovalFix(text string, pos int, end int) RuleFix {
+	lineStart := strings.LastIndexByte(text[:pos], '\n') + 1
+	lineEnd := len(text)
+	if i := strings.IndexByte(text[end:], '\n'); i >= 0 {
+		lineEnd = end + i
+	}
+	before := text[lineStart:pos]
+	after := text[end:lineEnd]
+	isBlank := func(s string) bool { return strings.TrimLeft(s, " \t\r") == "" }
+
+	switch {
+	case isBlank(before) && isBlank(after):
+		if lineEnd < len(text) {
+			return RuleFixRemoveRange(core.NewTextRange(lineStart, lineEnd+1))
+		}
+		if lineStart > 0 {
+			// Last line without a trailing newline: take the preceding one.
+			return RuleFixRemoveRange(core.NewTextRange(lineStart-1, lineEnd))
+		}
+		return RuleFixRemoveRange(core.NewTextRange(lineStart, lineEnd))
+	case isBlank(after):
+		return RuleFixRemoveRange(core.NewTextRange(lineStart+len(strings.TrimRight(before, " \t")), end))
+	case isBlank(before) || strings.HasSuffix(before, " ") || strings.HasSuffix(before, "\t"):
+		return RuleFixRemoveRange(core.NewTextRange(pos, end+len(after)-len(strings.TrimLeft(after, " \t"))))
+	case strings.HasPrefix(after, " ") || strings.HasPrefix(after, "\t"):
+		return RuleFixRemoveRange(core.NewTextRange(pos, end))
+	default:
+		return RuleFixReplaceRange(core.NewTextRange(pos, end), " ")
 	}
-	return allDisabled
 }
@@ -1,6 +1,8 @@
 package rule
 
 import (
+	"slices"
+	"strings"
 	"testing"
 )
 
@@ -612,3 +614,187 @@ func TestDisableManagerBlockAndLineCombined(t *testing.T) {
 		})
 	}
 }
+
+// ---------------------------------------------------------------------------
+// UnusedDirectiveDiagnostics
+// ---------------------------------------------------------------------------
+
+func TestUnusedDirectiveDiagnostics(t *testing.T) {
+	type suppressed struct {
+		rule   string
+		marker string // the report is at the last occurrence of marker
+	}
+	type unused struct {
+		message string
+		fixed   string // source after applying only this diagnostic's fix
+	}
+	tests := []struct {
+		name             string
+		source           string
+		suppressed       []suppressed
+		unchecked        []string
+		includeWildcards bool
+		want             []unused
+	}{
+		{
+			name:       "used next-line directive",
+			source:     "// eslint-disable-next-line no-alert\nalert(1)\n",
+			suppressed: []suppressed{{"no-alert", "alert(1)"}},
+		},
+		{
+			name:   "unused next-line directive removes its line",
+			source: "// eslint-disable-next-line no-alert\nfoo()\n",
+			want: []unused{{
+				"Unused eslint-disable directive (no problems were reported from 'no-alert').",
+				"foo()\n",
+			}},
+		},
+		{
+			name:   "trailing comment takes the whitespace before it",
+			source: "foo(); // rslint-disable-line no-alert\n",
+			want: []unused{{
+				"Unused rslint-disable directive (no problems were reported from 'no-alert').",
+				"foo();\n",
+			}},
+		},
+		{
+			name:   "comment before code keeps the code",
+			source: "const a = /* eslint-disable-line no-alert */ 1;\n",
+			want: []unused{{
+				"Unused eslint-disable directive (no problems were reported from 'no-alert').",
+				"const a = 1;\n",
+			}},
+		},
+		{
+			name:   "last line without a newline",
+			source: "foo()\n/* eslint-disable no-alert, no-console, no-debugger */",
+			want: []unused{{
+				"Unused eslint-disable directive (no problems were reported from 'no-alert', 'no-console', or 'no-debugger').",
+				"foo()",
+			}},
+		},
+		{
+			name:       "stale trailing name takes the comma before it",
+			source:     "alert(1) // eslint-disable-line no-alert, no-console -- why\n",
+			suppressed: []suppressed{{"no-alert", "alert(1)"}},
+			want: []unused{{
+				"Unused eslint-disable directive (no problems were reported from 'no-console').",
+				"alert(1) // eslint-disable-line no-alert -- why\n",
+			}},
+		},
+		{
+			name:       "stale leading name takes the comma after it",
+			source:     "alert(1) // eslint-disable-line no-console,  no-alert\n",
+			suppressed: []suppressed{{"no-alert", "alert(1)"}},
+			want: []unused{{
+				"Unused eslint-disable directive (no problems were reported from 'no-console').",
+				"alert(1) // eslint-disable-line no-alert\n",
+			}},
+		},
+		{
+			name:      "unchecked names are never reported",
+			source:    "// eslint-disable-next-line no-alert, plugin/rule\nfoo()\n",
+			unchecked: []string{"plugin/rule"},
+			want: []unused{{
+				"Unused eslint-disable directive (no problems were reported from 'no-alert').",
+				"// eslint-disable-next-line plugin/rule\nfoo()\n",
+			}},
+		},
+		{
+			name:             "unused wildcard",
+			source:           "/* eslint-disable */\nfoo()\n",
+			includeWildcards: true,
+			want: []unused{{
+				"Unused eslint-disable directive (no problems were reported).",
+				"foo()\n",
+			}},
+		},
+		{
+			name:             "used wildcard",
+			source:           "/* eslint-disable */\nalert(1)\n",
+			suppressed:       []suppressed{{"no-alert", "alert(1)"}},
+			includeWildcards: true,
+		},
+		{
+			name:   "wildcards skipped when they cannot be judged",
+			source: "/* eslint-disable */\nfoo()\n",
+		},
+		{
+			name:       "the latest block directive is credited",
+			source:     "/* eslint-disable no-alert */\n/* eslint-disable no-alert */\nalert(1)\n",
+			suppressed: []suppressed{{"no-alert", "alert(1)"}},
+			want: []unused{{
+				"Unused eslint-disable directive (no problems were reported from 'no-alert').",
+				"/* eslint-disable no-alert */\nalert(1)\n",
+			}},
+		},
+		{
+			name:       "a line directive is credited before a block range",
+			source:     "/* eslint-disable no-alert */\nalert(1) // eslint-disable-line no-alert\n",
+			suppressed: []suppressed{{"no-alert", "alert(1)"}},
+			want: []unused{{
+				"Unused eslint-disable directive (no problems were reported from 'no-alert').",
+				"alert(1) // eslint-disable-line no-alert\n",
+			}},
+		},
+		{
+			name:       "re-enabled rule is credited to the disable after the enable",
+			source:     "/* eslint-disable no-alert */\n/* eslint-enable no-alert */\nfoo()\n/* eslint-disable no-alert */\nalert(1)\n",
+			suppressed: []suppressed{{"no-alert", "alert(1)"}},
+			want: []unused{{
+				"Unused eslint-disable directive (no problems were reported from 'no-alert').",
+				"/* eslint-enable no-alert */\nfoo()\n/* eslint-disable no-alert */\nalert(1)\n",
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sourceFile := parseCommentStoreSource(t, tt.source)
+			dm := NewDisableManager(sourceFile, NewCommentStore(sourceFile))
+			for _, s := range tt.suppressed {
+				if !dm.IsRuleDisabled(s.rule, strings.LastIndex(tt.source, s.marker)) {
+					t.Fatalf("%s at %q was not suppressed", s.rule, s.marker)
+				}
+			}
+			isChecked := func(ruleName string) bool { return !slices.Contains(tt.unchecked, ruleName) }
+
+			diagnostics := dm.UnusedDirectiveDiagnostics(SeverityWarning, isChecked, tt.includeWildcards)
+			if len(diagnostics) != len(tt.want) {
+				t.Fatalf("got %d diagnostics, want %d: %+v", len(diagnostics), len(tt.want), diagnostics)
+			}
+			for i, d := range diagnostics {
+				if d.RuleName != UnusedDisableDirectiveRuleName || d.Severity != SeverityWarning {
+					t.Errorf("diagnostic %d: rule %q severity %v", i, d.RuleName, d.Severity)
+				}
+				if d.Message.Description != tt.want[i].message {
+					t.Errorf("diagnostic %d message:\n got %q\nwant %q", i, d.Message.Description, tt.want[i].message)
+				}
+				fixes := d.Fixes()
+				if len(fixes) != 1 {
+					t.Fatalf("diagnostic %d: got %d fixes, want 1", i, len(fixes))
+				}
+				fixed := tt.source[:fixes[0].Range.Pos()] + fixes[0].Text + tt.source[fixes[0].Range.End():]
+				if fixed != tt.want[i].fixed {
+					t.Errorf("diagnostic %d fix:\n got %q\nwant %q", i, fixed, tt.want[i].fixed)
+				}
+			}
+		})
+	}
+}
+
+func TestUnusedDirectiveDiagnosticsNamePosition(t *testing.T) {
+	source := "alert(1) // eslint-disable-line no-alert,no-console\n"
+	sourceFile := parseCommentStoreSource(t, source)
+	dm := NewDisableManager(sourceFile, NewCommentStore(sourceFile))
+	dm.IsRuleDisabled("no-alert", 0)
+
+	diagnostics := dm.UnusedDirectiveDiagnostics(SeverityError, func(string) bool { return true }, true)
+	if len(diagnostics) != 1 {
+		t.Fatalf("got %d diagnostics, want 1", len(diagnostics))
+	}
+	r := diagnostics[0].Range
+	if got := source[r.Pos():r.End()]; got != "no-console" {
+		t.Errorf("diagnostic range covers %q, want the stale name", got)
+	}
+}
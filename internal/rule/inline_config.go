@@ -0,0 +1,379 @@
+package rule
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/core"
+)
+
+// IgnoredInlineConfigRuleName is the rule name carried by warnings for inline
+// configuration comments in files whose linterOptions.noInlineConfig is set.
+// It is not a registered rule.
+const IgnoredInlineConfigRuleName = "ignored-inline-config"
+
+// IgnoredInlineConfigDiagnostics warns about every disable/enable directive,
+// rule comment and `/* global */` comment in a file whose config turned inline
+// configuration off, since those comments no longer do anything. The message
+// matches ESLint's. --no-inline-config on the command line ignores the same
+// comments without warning, so callers only use this for the config setting.
+func IgnoredInlineConfigDiagnostics(sourceFile *ast.SourceFile, comments *CommentStore) []RuleDiagnostic {
+	if sourceFile == nil {
+		return nil
+	}
+	text := sourceFile.Text()
+	if !strings.Contains(text, "slint") && !mayContainInlineGlobalDirective(text) {
+		return nil
+	}
+
+	var diagnostics []RuleDiagnostic
+	for _, comment := range comments.All() {
+		content, _, ok := directiveCommentContent(text, comment)
+		if !ok {
+			continue
+		}
+		name := inlineConfigDirectiveName(content, comment.Kind)
+		if name == "" {
+			continue
+		}
+		written := "//" + name
+		if comment.Kind == ast.KindMultiLineCommentTrivia {
+			written = "/*" + name + "*/"
+		}
+		diagnostics = append(diagnostics, RuleDiagnostic{
+			Range:    core.NewTextRange(comment.Pos(), comment.End()),
+			RuleName: IgnoredInlineConfigRuleName,
+			Message: RuleMessage{
+				Id:          "ignoredInlineConfig",
+				Description: fmt.Sprintf("'%s' has no effect because you have 'noInlineConfig' setting in your config.", written),
+			},
+			SourceFile: sourceFile,
+			FilePath:   sourceFile.FileName(),
+			Severity:   SeverityWarning,
+		})
+	}
+	return diagnostics
+}
+
+// inlineConfigDirectiveName returns the directive a comment starts with, such
+// as "eslint-disable-next-line" or "global", or "" for an ordinary comment.
+func inlineConfigDirectiveName(content string, kind ast.Kind) string {
+	if directive, nameLength := matchDirectiveName(content); directive != directiveNone {
+		return content[:nameLength]
+	}
+	if kind != ast.KindMultiLineCommentTrivia {
+		return ""
+	}
+	if restStart, ok := matchInlineGlobalsDirectiveRange(content, 0, len(content)); ok {
+		return content[:restStart]
+	}
+	if keyword, _, ok := matchInlineRuleConfigKeyword(content); ok {
+		return keyword
+	}
+	return ""
+}
+
+// InlineConfigRuleName is the rule name carried by problems in inline
+// configuration comments themselves, such as a rule comment that cannot be
+// parsed or names an unknown rule. It is not a registered rule.
+const InlineConfigRuleName = "inline-config"
+
+// UnusedInlineConfigRuleName is the rule name carried by
+// linterOptions.reportUnusedInlineConfigs reports. It is not a registered rule.
+const UnusedInlineConfigRuleName = "unused-inline-config"
+
+// InlineRuleResolution is the outcome of one `/* eslint name: value */` entry.
+type InlineRuleResolution struct {
+	// Rule replaces the file's configured rule of the same name. It is only
+	// meaningful when Enabled; a disabled entry removes the rule.
+	Rule    ConfiguredRule
+	Enabled bool
+	// Unchanged is set when the entry repeats the rule's configured severity,
+	// and its options when it gives any.
+	Unchanged bool
+}
+
+// InlineRuleResolver resolves one inline rule entry against a file's
+// configuration. value is the entry as written: a severity, or an array of a
+// severity followed by options. An error makes the entry invalid and is
+// reported at the comment.
+type InlineRuleResolver func(name string, value any) (InlineRuleResolution, error)
+
+// ErrUnknownInlineRule is the InlineRuleResolver error for a rule name the
+// file's configuration cannot run.
+var ErrUnknownInlineRule = errors.New("unknown rule")
+
+type inlineRuleEntry struct {
+	name  string
+	value any
+}
+
+// inlineConfigDescription matches the `--` separator before a directive
+// comment's description.
+var inlineConfigDescription = regexp.MustCompile(`\s-{2,}\s`)
+
+// ApplyInlineRuleConfigs applies a file's `/* eslint name: value */` comments
+// on top of its configured rules and returns the resulting rule list together
+// with the problems found in those comments. rules is shared configuration
+// state and is never modified; it is returned as is when no comment applies.
+//
+// A rule configured by an earlier comment keeps that configuration, as in
+// ESLint. `/* eslint-env */` comments are reported, since flat config has no
+// environments to turn on.
+func ApplyInlineRuleConfigs(sourceFile *ast.SourceFile, comments *CommentStore, rules []ConfiguredRule, environment *RuleEnvironment) ([]ConfiguredRule, []RuleDiagnostic) {
+	if sourceFile == nil || environment == nil || environment.InlineRules == nil {
+		return rules, nil
+	}
+	text := sourceFile.Text()
+	if !strings.Contains(text, "slint") {
+		return rules, nil
+	}
+
+	var diagnostics []RuleDiagnostic
+	report := func(comment *ast.CommentRange, ruleName string, severity DiagnosticSeverity, id string, message string) {
+		diagnostics = append(diagnostics, RuleDiagnostic{
+			Range:      core.NewTextRange(comment.Pos(), comment.End()),
+			RuleName:   ruleName,
+			Message:    RuleMessage{Id: id, Description: message},
+			SourceFile: sourceFile,
+			FilePath:   sourceFile.FileName(),
+			Severity:   severity,
+		})
+	}
+
+	var resolved map[string]InlineRuleResolution
+	var order []string
+	for _, comment := range comments.All() {
+		if comment.Kind != ast.KindMultiLineCommentTrivia {
+			continue
+		}
+		content, _, _ := directiveCommentContent(text, comment)
+		keyword, rest, ok := matchInlineRuleConfigKeyword(content)
+		if !ok {
+			continue
+		}
+		if keyword == "eslint-env" {
+			report(comment, InlineConfigRuleName, SeverityError, "eslintEnv", "/* eslint-env */ comments are no longer recognized when linting with flat config.")
+			continue
+		}
+		if loc := inlineConfigDescription.FindStringIndex(rest); loc != nil {
+			rest = rest[:loc[0]]
+		}
+		entries, normalized, err := parseInlineRuleConfig(rest)
+		if err != nil {
+			report(comment, InlineConfigRuleName, SeverityError, "invalidInlineConfig",
+				fmt.Sprintf("Failed to parse JSON from '%s': %v", normalized, err))
+			continue
+		}
+		for _, entry := range entries {
+			if _, seen := resolved[entry.name]; seen {
+				report(comment, InlineConfigRuleName, SeverityError, "duplicateInlineConfig",
+					fmt.Sprintf("Rule %q is already configured by another configuration comment in the preceding code. This configuration is ignored.", entry.name))
+				continue
+			}
+			resolution, err := environment.InlineRules(entry.name, entry.value)
+			if errors.Is(err, ErrUnknownInlineRule) {
+				report(comment, InlineConfigRuleName, SeverityError, "unknownRule",
+					fmt.Sprintf("Definition for rule '%s' was not found.", entry.name))
+				continue
+			}
+			if err != nil {
+				report(comment, InlineConfigRuleName, SeverityError, "invalidInlineConfig",
+					fmt.Sprintf("Inline configuration for rule %q is invalid: %v", entry.name, err))
+				continue
+			}
+			if resolution.Unchanged && environment.LinterOptions.ReportUnusedInlineConfigs {
+				report(comment, UnusedInlineConfigRuleName, environment.LinterOptions.UnusedInlineConfigSeverity, "unusedInlineConfig",
+					unusedInlineConfigMessage(entry, resolution))
+			}
+			if resolved == nil {
+				resolved = make(map[string]InlineRuleResolution)
+			}
+			resolved[entry.name] = resolution
+			order = append(order, entry.name)
+		}
+	}
+	if len(resolved) == 0 {
+		return rules, diagnostics
+	}
+
+	result := make([]ConfiguredRule, 0, len(rules)+len(resolved))
+	for _, configured := range rules {
+		if _, overridden := resolved[configured.Name]; !overridden {
+			result = append(result, configured)
+		}
+	}
+	for _, name := range order {
+		if resolution := resolved[name]; resolution.Enabled {
+			result = append(result, resolution.Rule)
+		}
+	}
+	// Keep the name order the config resolver produces; listener
+	// registration order follows it.
+	slices.SortFunc(result, func(a, b ConfiguredRule) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return result, diagnostics
+}
+
+func unusedInlineConfigMessage(entry inlineRuleEntry, resolution InlineRuleResolution) string {
+	level := "off"
+	if resolution.Enabled {
+		level = "error"
+		if resolution.Rule.Severity == SeverityWarning {
+			level = "warn"
+		}
+	}
+	if values, ok := entry.value.([]any); ok && len(values) > 1 {
+		return fmt.Sprintf("Unused inline config ('%s' is already configured to '%s' with the given options).", entry.name, level)
+	}
+	return fmt.Sprintf("Unused inline config ('%s' is already configured to '%s').", entry.name, level)
+}
+
+// matchInlineRuleConfigKeyword recognizes the content of a `/* eslint ... */`,
+// `/* rslint ... */` or `/* eslint-env ... */` block comment and returns the
+// keyword and the text after it.
+func matchInlineRuleConfigKeyword(content string) (string, string, bool) {
+	for _, keyword := range [...]string{"eslint-env", "eslint", "rslint"} {
+		if !strings.HasPrefix(content, keyword) {
+			continue
+		}
+		rest := content[len(keyword):]
+		if rest == "" {
+			return keyword, rest, true
+		}
+		if r, _ := utf8.DecodeRuneInString(rest); unicode.IsSpace(r) {
+			return keyword, rest, true
+		}
+	}
+	return "", "", false
+}
+
+// inlineRuleName matches an unquoted rule name before its colon.
+var inlineRuleName = regexp.MustCompile(`([-a-zA-Z0-9/@]+):`)
+
+// inlineRuleMissingComma matches two entries separated only by whitespace.
+var inlineRuleMissingComma = regexp.MustCompile(`(\]|[0-9])\s+"`)
+
+// parseInlineRuleConfig parses the body of a rule comment the way ESLint
+// does: first as comma-separated `name: value` pairs whose severities may be
+// left unquoted, then as the members of a JSON object whose rule names may be
+// left unquoted. Entries keep source order. The text parsed as JSON is
+// returned for error messages.
+func parseInlineRuleConfig(body string) ([]inlineRuleEntry, string, error) {
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return nil, "", nil
+	}
+	if entries, ok := parseInlineRulePairs(body); ok {
+		return entries, body, nil
+	}
+
+	normalized := inlineRuleName.ReplaceAllString(body, `"$1":`)
+	normalized = inlineRuleMissingComma.ReplaceAllString(normalized, `$1,"`)
+	entries, err := decodeInlineRuleObject("{" + normalized + "}")
+	return entries, normalized, err
+}
+
+// parseInlineRulePairs parses `name: value, ...`, where a value is a bare
+// severity or JSON.
+func parseInlineRulePairs(body string) ([]inlineRuleEntry, bool) {
+	var entries []inlineRuleEntry
+	for _, part := range splitInlineRulePairs(body) {
+		name, rawValue, ok := strings.Cut(part, ":")
+		name = strings.TrimSpace(name)
+		if unquoted, err := strconv.Unquote(name); err == nil {
+			name = unquoted
+		}
+		if !ok || name == "" || strings.ContainsFunc(name, unicode.IsSpace) {
+			return nil, false
+		}
+		var value any
+		switch rawValue = strings.TrimSpace(rawValue); rawValue {
+		case "off", "warn", "error":
+			value = rawValue
+		default:
+			if err := json.Unmarshal([]byte(rawValue), &value); err != nil {
+				return nil, false
+			}
+		}
+		entries = appendInlineRuleEntry(entries, name, value)
+	}
+	return entries, true
+}
+
+// splitInlineRulePairs splits body at the commas outside brackets, braces and
+// strings.
+func splitInlineRulePairs(body string) []string {
+	var parts []string
+	depth, start := 0, 0
+	inString, escaped := false, false
+	for i := range len(body) {
+		c := body[i]
+		switch {
+		case escaped:
+			escaped = false
+		case inString:
+			escaped = c == '\\'
+			inString = c != '"'
+		case c == '"':
+			inString = true
+		case c == '[' || c == '{':
+			depth++
+		case c == ']' || c == '}':
+			depth--
+		case c == ',' && depth == 0:
+			parts = append(parts, body[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, body[start:])
+}
+
+// appendInlineRuleEntry adds an entry; a repeated name keeps its last value,
+// like JSON.parse.
+func appendInlineRuleEntry(entries []inlineRuleEntry, name string, value any) []inlineRuleEntry {
+	if index := slices.IndexFunc(entries, func(entry inlineRuleEntry) bool { return entry.name == name }); index >= 0 {
+		entries[index].value = value
+		return entries
+	}
+	return append(entries, inlineRuleEntry{name: name, value: value})
+}
+
+// decodeInlineRuleObject decodes a JSON object into its members in source
+// order.
+func decodeInlineRuleObject(source string) ([]inlineRuleEntry, error) {
+	decoder := json.NewDecoder(strings.NewReader(source))
+	if _, err := decoder.Token(); err != nil {
+		return nil, err
+	}
+	var entries []inlineRuleEntry
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+		name, _ := token.(string)
+		var value any
+		if err := decoder.Decode(&value); err != nil {
+			return nil, err
+		}
+		entries = appendInlineRuleEntry(entries, name, value)
+	}
+	if _, err := decoder.Token(); err != nil {
+		return nil, err
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return nil, errors.New("unexpected content after the rule list")
+	}
+	return entries, nil
+}
@@ -0,0 +1,188 @@
+package rule
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestIgnoredInlineConfigDiagnostics(t *testing.T) {
+	source := "// eslint-disable-next-line no-alert\nalert(1)\n" +
+		"/* global foo, bar */\n" +
+		"foo(); // rslint-disable-line no-console -- why\n" +
+		"/* eslint-enable */\n" +
+		"/* eslint no-alert: off */\n" +
+		"// just a comment about eslint-disable\n"
+	sourceFile := parseCommentStoreSource(t, source)
+
+	diagnostics := IgnoredInlineConfigDiagnostics(sourceFile, NewCommentStore(sourceFile))
+	want := []struct {
+		message string
+		comment string
+	}{
+		{"'//eslint-disable-next-line' has no effect because you have 'noInlineConfig' setting in your config.", "// eslint-disable-next-line no-alert"},
+		{"'/*global*/' has no effect because you have 'noInlineConfig' setting in your config.", "/* global foo, bar */"},
+		{"'//rslint-disable-line' has no effect because you have 'noInlineConfig' setting in your config.", "// rslint-disable-line no-console -- why"},
+		{"'/*eslint-enable*/' has no effect because you have 'noInlineConfig' setting in your config.", "/* eslint-enable */"},
+		{"'/*eslint*/' has no effect because you have 'noInlineConfig' setting in your config.", "/* eslint no-alert: off */"},
+	}
+	if len(diagnostics) != len(want) {
+		t.Fatalf("got %d diagnostics, want %d: %+v", len(diagnostics), len(want), diagnostics)
+	}
+	for i, d := range diagnostics {
+		if d.RuleName != IgnoredInlineConfigRuleName || d.Severity != SeverityWarning {
+			t.Errorf("diagnostic %d: rule %q severity %v", i, d.RuleName, d.Severity)
+		}
+		if d.Message.Description != want[i].message {
+			t.Errorf("diagnostic %d message:\n got %q\nwant %q", i, d.Message.Description, want[i].message)
+		}
+		if got := source[d.Range.Pos():d.Range.End()]; got != want[i].comment {
+			t.Errorf("diagnostic %d range covers %q, want %q", i, got, want[i].comment)
+		}
+	}
+}
+
+func TestDisableManagerIgnoreDirectives(t *testing.T) {
+	source := "/* eslint-disable */\nalert(1) // eslint-disable-line no-alert\nfoo() // eslint-disable-line no-console\n"
+	sourceFile := parseCommentStoreSource(t, source)
+	dm := NewDisableManager(sourceFile, NewCommentStore(sourceFile))
+	dm.IgnoreDirectives()
+
+	if dm.IsRuleDisabled("no-alert", strings.Index(source, "alert(1)")) {
+		t.Error("Expected directives to be ignored")
+	}
+	if diagnostics := dm.UnusedDirectiveDiagnostics(SeverityWarning, func(string) bool { return true }, true); len(diagnostics) != 0 {
+		t.Errorf("Expected no unused directive reports for ignored directives, got %+v", diagnostics)
+	}
+}
+
+func TestParseInlineRuleConfig(t *testing.T) {
+	tests := []struct {
+		body    string
+		want    []inlineRuleEntry
+		wantErr bool
+	}{
+		{body: "", want: nil},
+		{body: "no-console: off", want: []inlineRuleEntry{{"no-console", "off"}}},
+		{body: "no-alert: 0, no-console: warn", want: []inlineRuleEntry{{"no-alert", float64(0)}, {"no-console", "warn"}}},
+		{body: `no-console: "off", max-len: ["warn", 120]`, want: []inlineRuleEntry{{"no-console", "off"}, {"max-len", []any{"warn", float64(120)}}}},
+		{body: `"quotes": ["error", "double"]`, want: []inlineRuleEntry{{"quotes", []any{"error", "double"}}}},
+		{body: `@typescript-eslint/no-explicit-any: ["error", {fixToUnknown: true}]`, want: []inlineRuleEntry{{"@typescript-eslint/no-explicit-any", []any{"error", map[string]any{"fixToUnknown": true}}}}},
+		{body: `no-alert: ["error"] no-console: 1`, want: []inlineRuleEntry{{"no-alert", []any{"error"}}, {"no-console", float64(1)}}},
+		{body: `no-alert: 2, no-alert: 0`, want: []inlineRuleEntry{{"no-alert", float64(0)}}},
+		{body: "no-console: [", wantErr: true},
+		{body: "no-console", wantErr: true},
+		{body: `no-alert: off, max-len: ["warn", {"ignorePattern": "a,b"}]`, want: []inlineRuleEntry{{"no-alert", "off"}, {"max-len", []any{"warn", map[string]any{"ignorePattern": "a,b"}}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.body, func(t *testing.T) {
+			got, _, err := parseInlineRuleConfig(tt.body)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseInlineRuleConfig(%q) error = %v, wantErr %v", tt.body, err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseInlineRuleConfig(%q) = %#v, want %#v", tt.body, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyInlineRuleConfigs(t *testing.T) {
+	environment := &RuleEnvironment{
+		LinterOptions: LinterOptions{ReportUnusedInlineConfigs: true, UnusedInlineConfigSeverity: SeverityWarning},
+	}
+	configured := []ConfiguredRule{
+		{Name: "no-alert", Severity: SeverityError, Environment: environment},
+		{Name: "no-console", Severity: SeverityWarning, Environment: environment},
+	}
+	environment.InlineRules = func(name string, value any) (InlineRuleResolution, error) {
+		if name == "unknown" {
+			return InlineRuleResolution{}, ErrUnknownInlineRule
+		}
+		var severityValue any = value
+		var options []any
+		if values, ok := value.([]any); ok {
+			severityValue, options = values[0], values[1:]
+		}
+		var severity DiagnosticSeverity
+		switch severityValue {
+		case "off", float64(0):
+			return InlineRuleResolution{Unchanged: name == "no-debugger"}, nil
+		case "warn", float64(1):
+			severity = SeverityWarning
+		case "error", float64(2):
+			severity = SeverityError
+		default:
+			return InlineRuleResolution{}, errors.New("invalid severity")
+		}
+		return InlineRuleResolution{
+			Rule:      ConfiguredRule{Name: name, Severity: severity, Options: options, Environment: environment},
+			Enabled:   true,
+			Unchanged: name == "no-console" && severity == SeverityWarning && len(options) == 0,
+		}, nil
+	}
+
+	source := "/* eslint no-alert: off, max-len: [\"warn\", 80] -- legacy file */\n" +
+		"/* eslint no-console: warn, no-debugger: 0 */\n" +
+		"/* eslint max-len: error */\n" +
+		"/* eslint unknown: \"error\", eqeqeq: \"maybe\" */\n" +
+		"/* eslint-env node */\n" +
+		"/* eslint no-console: [ */\n" +
+		"// eslint no-debugger: error\n" +
+		"/* eslint-disable no-alert */\n"
+	sourceFile := parseCommentStoreSource(t, source)
+	rules, diagnostics := ApplyInlineRuleConfigs(sourceFile, NewCommentStore(sourceFile), configured, environment)
+
+	var names []string
+	for _, r := range rules {
+		names = append(names, r.Name)
+	}
+	if want := []string{"max-len", "no-console"}; !reflect.DeepEqual(names, want) {
+		t.Fatalf("rules = %v, want %v", names, want)
+	}
+	if rules[0].Severity != SeverityWarning || !reflect.DeepEqual(rules[0].Options, []any{float64(80)}) {
+		t.Errorf("max-len = %+v, want the first comment's configuration", rules[0])
+	}
+	if configured[0].Name != "no-alert" || len(configured) != 2 {
+		t.Errorf("configured rules were modified: %+v", configured)
+	}
+
+	want := []struct {
+		ruleName string
+		severity DiagnosticSeverity
+		message  string
+	}{
+		{UnusedInlineConfigRuleName, SeverityWarning, "Unused inline config ('no-console' is already configured to 'warn')."},
+		{UnusedInlineConfigRuleName, SeverityWarning, "Unused inline config ('no-debugger' is already configured to 'off')."},
+		{InlineConfigRuleName, SeverityError, `Rule "max-len" is already configured by another configuration comment in the preceding code. This configuration is ignored.`},
+		{InlineConfigRuleName, SeverityError, "Definition for rule 'unknown' was not found."},
+		{InlineConfigRuleName, SeverityError, `Inline configuration for rule "eqeqeq" is invalid: invalid severity`},
+		{InlineConfigRuleName, SeverityError, "/* eslint-env */ comments are no longer recognized when linting with flat config."},
+	}
+	if len(diagnostics) != len(want)+1 {
+		t.Fatalf("got %d diagnostics, want %d: %+v", len(diagnostics), len(want)+1, diagnostics)
+	}
+	for i, w := range want {
+		d := diagnostics[i]
+		if d.RuleName != w.ruleName || d.Severity != w.severity || d.Message.Description != w.message {
+			t.Errorf("diagnostic %d = %s %v %q, want %s %v %q", i, d.RuleName, d.Severity, d.Message.Description, w.ruleName, w.severity, w.message)
+		}
+	}
+	if last := diagnostics[len(want)].Message.Description; !strings.HasPrefix(last, `Failed to parse JSON from '"no-console": [': `) {
+		t.Errorf("parse failure message = %q", last)
+	}
+	if got := source[diagnostics[3].Range.Pos():diagnostics[3].Range.End()]; got != `/* eslint unknown: "error", eqeqeq: "maybe" */` {
+		t.Errorf("diagnostic range covers %q, want the comment", got)
+	}
+}
+
+func TestApplyInlineRuleConfigsWithoutResolver(t *testing.T) {
+	source := "/* eslint no-alert: off */\nalert(1)\n"
+	sourceFile := parseCommentStoreSource(t, source)
+	configured := []ConfiguredRule{{Name: "no-alert", Environment: &RuleEnvironment{}}}
+	rules, diagnostics := ApplyInlineRuleConfigs(sourceFile, NewCommentStore(sourceFile), configured, configured[0].Environment)
+	if len(rules) != 1 || len(diagnostics) != 0 {
+		t.Errorf("got rules %+v and diagnostics %+v, want the configured rules unchanged", rules, diagnostics)
+	}
+}
@@ -0,0 +1,51 @@
+package rule
+
+import "strings"
+
+// RuleType classifies what a rule reports, as ESLint's meta.type does.
+type RuleType string
+
+const (
+	// RuleTypeProblem reports code that is wrong or will likely fail.
+	RuleTypeProblem RuleType = "problem"
+	// RuleTypeSuggestion reports code that could be written in a better way.
+	RuleTypeSuggestion RuleType = "suggestion"
+	// RuleTypeLayout reports formatting only: whitespace, semicolons, and the
+	// like.
+	RuleTypeLayout RuleType = "layout"
+)
+
+// Fixable is the kind of autofix a rule provides, as ESLint's meta.fixable
+// does. The empty value means the rule has no autofix.
+type Fixable string
+
+const (
+	FixableCode       Fixable = "code"
+	FixableWhitespace Fixable = "whitespace"
+)
+
+// RuleMeta describes a rule to tooling, modelled on ESLint's rule meta. It has
+// no effect on how the rule runs.
+type RuleMeta struct {
+	Type RuleType
+	// DocsURL is the rule's documentation page. The registry fills it from
+	// the rule name for native rules that leave it empty; see [DocsURL].
+	DocsURL        string
+	Fixable        Fixable
+	HasSuggestions bool
+	Deprecated     bool
+	// ReplacedBy names the rules that supersede a deprecated rule.
+	ReplacedBy []string
+	// MessageIds lists every message id the rule reports with.
+	MessageIds []string
+}
+
+// DocsURL returns the rslint.rs documentation page of the rule registered as
+// name: core rules live under eslint/, plugin rules under their scope.
+func DocsURL(name string) string {
+	scope, rest, scoped := strings.Cut(name, "/")
+	if !scoped {
+		return "https://rslint.rs/rules/eslint/" + name
+	}
+	return "https://rslint.rs/rules/" + strings.TrimPrefix(scope, "@") + "/" + rest
+}
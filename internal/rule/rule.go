@@ -53,6 +53,22 @@ func ParseSeverity(level string) DiagnosticSeverity {
 	}
 }
 
+// ParseSeverityLevel parses a severity given on the command line or through
+// the API: "off", "warn" or "error", or ESLint's numeric 0, 1 and 2. Unlike
+// ParseSeverity it rejects every other value.
+func ParseSeverityLevel(level string) (DiagnosticSeverity, bool) {
+	switch level {
+	case "error", "2":
+		return SeverityError, true
+	case "warn", "1":
+		return SeverityWarning, true
+	case "off", "0":
+		return SeverityOff, true
+	default:
+		return SeverityError, false
+	}
+}
+
 // NormalizeOptions returns a rule's options in ESLint context.options form
 // ([]any): an array value passes through as-is, and a bare value is wrapped
 // as a single-element array so every caller reads options[0] uniformly.
@@ -111,7 +127,10 @@ type Rule struct {
 	// only ESLint-plugin placeholder rules (whose options the Node worker's
 	// own ESLint validates) leave it nil.
 	Schema *Schema
-	Run    func(ctx RuleContext, options []any) RuleListeners
+	// Meta describes the rule to tooling: editors, report formats, and the
+	// API. It does not change how the rule runs.
+	Meta RuleMeta
+	Run  func(ctx RuleContext, options []any) RuleListeners
 }
 
 func CreateRule(r Rule) Rule {
@@ -119,6 +138,7 @@ func CreateRule(r Rule) Rule {
 		Name:             "@typescript-eslint/" + r.Name,
 		RequiresTypeInfo: r.RequiresTypeInfo,
 		Schema:           r.Schema,
+		Meta:             r.Meta,
 		Run:              r.Run,
 	}
 }
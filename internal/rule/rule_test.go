@@ -46,3 +46,37 @@ func TestNormalizeOptions(t *testing.T) {
 		t.Errorf("lone array option → [[a,b]], got %v", nested)
 	}
 }
+
+func TestParseSeverityLevel(t *testing.T) {
+	for level, want := range map[string]DiagnosticSeverity{
+		"error": SeverityError,
+		"2":     SeverityError,
+		"warn":  SeverityWarning,
+		"1":     SeverityWarning,
+		"off":   SeverityOff,
+		"0":     SeverityOff,
+	} {
+		got, ok := ParseSeverityLevel(level)
+		if !ok || got != want {
+			t.Errorf("ParseSeverityLevel(%q) = %v, %v; want %v, true", level, got, ok, want)
+		}
+	}
+	for _, level := range []string{"", "warning", "Error", "3", "true"} {
+		if _, ok := ParseSeverityLevel(level); ok {
+			t.Errorf("ParseSeverityLevel(%q) accepted an invalid level", level)
+		}
+	}
+}
+
+func TestDocsURL(t *testing.T) {
+	tests := map[string]string{
+		"no-debugger":                       "https://rslint.rs/rules/eslint/no-debugger",
+		"@typescript-eslint/no-unused-vars": "https://rslint.rs/rules/typescript-eslint/no-unused-vars",
+		"react-hooks/rules-of-hooks":        "https://rslint.rs/rules/react-hooks/rules-of-hooks",
+	}
+	for name, want := range tests {
+		if got := DocsURL(name); got != want {
+			t.Errorf("DocsURL(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
@@ -394,6 +394,9 @@ func checkTypeMembers(ctx rule.RuleContext, members []*ast.Node, opts Options) {
 var AccessorPairsRule = rule.Rule{
 	Name:   "accessor-pairs",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type: rule.RuleTypeSuggestion,
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 		if !opts.SetWithoutGet && !opts.GetWithoutSet {
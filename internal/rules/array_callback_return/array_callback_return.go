@@ -285,6 +285,10 @@ func checkCallbackReturn(ctx rule.RuleContext, funcNode *ast.Node, methodName st
 var ArrayCallbackReturnRule = rule.Rule{
 	Name:   "array-callback-return",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"expectedReturnValue", "expectedInside", "expectedNoReturnValue", "expectedAtEnd"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 
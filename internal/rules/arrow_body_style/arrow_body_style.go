@@ -250,6 +250,11 @@ func buildBlockBodyFixes(
 var ArrowBodyStyleRule = rule.Rule{
 	Name:   "arrow-body-style",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"expectedBlock", "unexpectedOtherBlock", "unexpectedEmptyBlock", "unexpectedObjectBlock", "unexpectedSingleBlock"},
+	},
 	Run: func(ctx rule.RuleContext, opts []any) rule.RuleListeners {
 		o := parseOptions(opts)
 		always := o.style == styleAlways
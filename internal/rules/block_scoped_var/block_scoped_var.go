@@ -20,7 +20,11 @@ import (
 var BlockScopedVarRule = rule.Rule{
 	Name:   "block-scoped-var",
 	Schema: rule.EmptyArraySchema,
-	Run:    run,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"outOfScope"},
+	},
+	Run: run,
 }
 
 // A varOccurrence is the one definition ESLint associates with a variable for
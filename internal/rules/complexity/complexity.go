@@ -20,6 +20,10 @@ const defaultThreshold = 20
 var ComplexityRule = rule.Rule{
 	Name:   "complexity",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"complex"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		threshold, isModified := parseOptions(options)
 
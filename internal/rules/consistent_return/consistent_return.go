@@ -60,6 +60,10 @@ func parseOptions(rawOptions []any) options {
 var ConsistentReturnRule = rule.Rule{
 	Name:   "consistent-return",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"missingReturn", "missingReturnValue", "unexpectedReturnValue"},
+	},
 	Run: func(ctx rule.RuleContext, rawOptions []any) rule.RuleListeners {
 		opts := parseOptions(rawOptions)
 
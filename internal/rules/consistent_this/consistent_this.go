@@ -85,6 +85,10 @@ type diagnostic struct {
 var ConsistentThisRule = rule.Rule{
 	Name:   "consistent-this",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"aliasNotAssignedToThis", "unexpectedAlias"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		aliases := parseOptions(options)
 		var diagnostics []diagnostic
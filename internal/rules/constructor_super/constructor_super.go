@@ -874,6 +874,10 @@ func switchHasSuper(switchStmt *ast.Node) bool {
 var ConstructorSuperRule = rule.Rule{
 	Name:   "constructor-super",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"missingAll", "missingSome", "duplicate", "badSuper"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindConstructor: func(node *ast.Node) {
@@ -86,6 +86,10 @@ type curlyChecker struct {
 var CurlyRule = rule.Rule{
 	Name:   "curly",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:    rule.RuleTypeSuggestion,
+		Fixable: rule.FixableCode,
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		c := &curlyChecker{
 			ctx:  ctx,
@@ -19,6 +19,10 @@ var schemaJSON []byte
 var DefaultCaseRule = rule.Rule{
 	Name:   "default-case",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"missingDefaultCase"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 
@@ -9,6 +9,10 @@ import (
 var DefaultCaseLastRule = rule.Rule{
 	Name:   "default-case-last",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"notLast"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindDefaultClause: func(node *ast.Node) {
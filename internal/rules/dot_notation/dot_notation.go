@@ -273,6 +273,11 @@ func buildUseBracketsFix(
 var DotNotationRule = rule.Rule{
 	Name:   "dot-notation",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"useDot", "useBrackets"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 
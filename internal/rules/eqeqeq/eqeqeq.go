@@ -148,6 +148,12 @@ func parseOptions(options []any) eqeqeqOptions {
 var EqeqeqRule = rule.Rule{
 	Name:   "eqeqeq",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:           rule.RuleTypeSuggestion,
+		Fixable:        rule.FixableCode,
+		HasSuggestions: true,
+		MessageIds:     []string{"unexpected", "replaceOperator"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 
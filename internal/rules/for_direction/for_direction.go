@@ -546,6 +546,10 @@ func (checker *forDirectionChecker) report(node *ast.Node, forStatement *ast.For
 var ForDirectionRule = rule.Rule{
 	Name:   "for-direction",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"incorrectDirection"},
+	},
 	Run: func(ctx rule.RuleContext, _ []any) rule.RuleListeners {
 		checker := &forDirectionChecker{ctx: ctx}
 		return rule.RuleListeners{ast.KindForStatement: checker.check}
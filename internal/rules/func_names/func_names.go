@@ -16,6 +16,9 @@ var schemaJSON []byte
 var FuncNamesRule = rule.Rule{
 	Name:   "func-names",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type: rule.RuleTypeSuggestion,
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 
@@ -239,6 +239,10 @@ func checkPropertyDescriptor(ctx rule.RuleContext, node *ast.Node, opts Options)
 var GetterReturnRule = rule.Rule{
 	Name:   "getter-return",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"expected", "expectedAlways"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 		return rule.RuleListeners{
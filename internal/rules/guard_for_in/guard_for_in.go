@@ -9,6 +9,10 @@ import (
 var GuardForInRule = rule.Rule{
 	Name:   "guard-for-in",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"wrap"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindForInStatement: func(node *ast.Node) {
@@ -100,6 +100,10 @@ var schemaJSON []byte
 var IdLengthRule = rule.Rule{
 	Name:   "id-length",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"tooShortPrivate", "tooShort", "tooLongPrivate", "tooLong"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 
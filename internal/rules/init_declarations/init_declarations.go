@@ -25,6 +25,9 @@ var schemaJSON []byte
 var InitDeclarationsRule = rule.Rule{
 	Name:   "init-declarations",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type: rule.RuleTypeSuggestion,
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 
@@ -20,6 +20,10 @@ const defaultMax = 1
 var MaxClassesPerFileRule = rule.Rule{
 	Name:   "max-classes-per-file",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"maximumExceeded"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 		classCount := 0
@@ -17,6 +17,10 @@ var schemaJSON []byte
 var MaxDepthRule = rule.Rule{
 	Name:   "max-depth",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"tooDeeply"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		maxDepth := parseMaxDepth(options)
 
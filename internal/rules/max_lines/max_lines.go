@@ -20,6 +20,10 @@ var schemaJSON []byte
 var MaxLinesRule = rule.Rule{
 	Name:   "max-lines",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"exceed"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		// The linter never fires a KindSourceFile listener, so run eagerly.
 		checkMaxLines(ctx, parseOptions(options))
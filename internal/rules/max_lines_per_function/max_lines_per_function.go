@@ -20,6 +20,10 @@ var schemaJSON []byte
 var MaxLinesPerFunctionRule = rule.Rule{
 	Name:   "max-lines-per-function",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"exceed"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 		sourceFile := ctx.SourceFile
@@ -17,6 +17,10 @@ var schemaJSON []byte
 var MaxNestedCallbacksRule = rule.Rule{
 	Name:   "max-nested-callbacks",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"exceed"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		threshold := parseThreshold(options)
 
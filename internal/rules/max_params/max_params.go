@@ -17,6 +17,10 @@ var schemaJSON []byte
 var MaxParamsRule = rule.Rule{
 	Name:   "max-params",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"exceed"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 
@@ -20,6 +20,10 @@ const defaultMax = 10
 var MaxStatementsRule = rule.Rule{
 	Name:   "max-statements",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"exceed"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 
@@ -55,6 +55,10 @@ func isGlobalThisOrWindow(node *ast.Node, globals rule.Globals) bool {
 var NoAlertRule = rule.Rule{
 	Name:   "no-alert",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"unexpected"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindCallExpression: func(node *ast.Node) {
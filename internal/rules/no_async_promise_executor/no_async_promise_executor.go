@@ -172,6 +172,10 @@ func noAsyncPromiseExecutorListeners(ctx rule.RuleContext) rule.RuleListeners {
 var NoAsyncPromiseExecutorRule = rule.Rule{
 	Name:   "no-async-promise-executor",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"async"},
+	},
 	Run: func(ctx rule.RuleContext, _ []any) rule.RuleListeners {
 		if !sourceMayUsePromise(ctx.SourceFile) {
 			return nil
@@ -139,6 +139,10 @@ func isFunctionNode(node *ast.Node) bool {
 var NoAwaitInLoopRule = rule.Rule{
 	Name:   "no-await-in-loop",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"unexpectedAwait"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindAwaitExpression: func(node *ast.Node) {
@@ -96,6 +96,10 @@ func buildUnexpectedMessage(operator string) rule.RuleMessage {
 var NoBitwiseRule = rule.Rule{
 	Name:   "no-bitwise",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"unexpected"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 
@@ -11,6 +11,10 @@ import (
 var NoCallerRule = rule.Rule{
 	Name:   "no-caller",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"unexpected"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindPropertyAccessExpression: func(node *ast.Node) {
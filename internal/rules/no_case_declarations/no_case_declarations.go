@@ -19,6 +19,11 @@ var addBracketsMessage = rule.RuleMessage{
 var NoCaseDeclarationsRule = rule.Rule{
 	Name:   "no-case-declarations",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:           rule.RuleTypeSuggestion,
+		HasSuggestions: true,
+		MessageIds:     []string{"unexpected", "addBrackets"},
+	},
 	Run: func(ctx rule.RuleContext, _ []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindCaseBlock: func(node *ast.Node) {
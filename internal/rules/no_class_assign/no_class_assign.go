@@ -45,6 +45,10 @@ func checkClassReassignments(classNode *ast.Node, nameNode *ast.Node, ctx *rule.
 var NoClassAssignRule = rule.Rule{
 	Name:   "no-class-assign",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"classReassignment"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		checkClass := func(node *ast.Node) {
 			nameNode := node.Name()
@@ -71,6 +71,10 @@ func isNegativeZero(node *ast.Node) bool {
 var NoCompareNegZeroRule = rule.Rule{
 	Name:   "no-compare-neg-zero",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"unexpected"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindPrefixUnaryExpression: func(node *ast.Node) {
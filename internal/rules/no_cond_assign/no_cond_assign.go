@@ -151,6 +151,10 @@ func parseOptions(options []any) string {
 var NoCondAssignRule = rule.Rule{
 	Name:   "no-cond-assign",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"missing", "unexpected"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		if parseOptions(options) == "always" {
 			return rule.RuleListeners{
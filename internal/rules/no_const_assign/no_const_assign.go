@@ -36,6 +36,10 @@ func isConstantBindingSymbol(symbol *ast.Symbol, sourceFile *ast.SourceFile) boo
 var NoConstAssignRule = rule.Rule{
 	Name:   "no-const-assign",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"const"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		// Every constant variable declaration contains one of these literal
 		// keywords. Avoid an identifier listener when the rule cannot report;
@@ -883,6 +883,10 @@ func findBinaryExpressionConstantOperand(ctx *rule.RuleContext, a, b *ast.Node,
 var NoConstantBinaryExpressionRule = rule.Rule{
 	Name:   "no-constant-binary-expression",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"constantBinaryOperand", "constantShortCircuit", "alwaysNew", "bothAlwaysNew"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindBinaryExpression: func(node *ast.Node) {
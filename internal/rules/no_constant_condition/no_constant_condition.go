@@ -725,6 +725,10 @@ func checkCondition(ctx *rule.RuleContext, node *ast.Node, opts Options) {
 var NoConstantConditionRule = rule.Rule{
 	Name:   "no-constant-condition",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"unexpected"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 
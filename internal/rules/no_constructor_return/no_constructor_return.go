@@ -69,6 +69,10 @@ func isReturnStatementInConstructor(node *ast.Node) bool {
 var NoConstructorReturnRule = rule.Rule{
 	Name:   "no-constructor-return",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"unexpected"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			// Check return statements
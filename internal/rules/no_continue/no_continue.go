@@ -9,6 +9,10 @@ import (
 var NoContinueRule = rule.Rule{
 	Name:   "no-continue",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"unexpected"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindContinueStatement: func(node *ast.Node) {
@@ -13,6 +13,10 @@ import (
 var NoControlRegexRule = rule.Rule{
 	Name:   "no-control-regex",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"unexpected"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		report := func(node *ast.Node, controlChars []string) {
 			ctx.ReportNode(node, rule.RuleMessage{
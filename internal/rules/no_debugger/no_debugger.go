@@ -9,6 +9,10 @@ import (
 var NoDebuggerRule = rule.Rule{
 	Name:   "no-debugger",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"no-debugger"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindDebuggerStatement: func(node *ast.Node) {
@@ -12,6 +12,10 @@ import (
 var NoDupeArgsRule = rule.Rule{
 	Name:   "no-dupe-args",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"unexpected"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		// NOTE: Unlike ESLint which reports once per duplicated name on the function node,
 		// we report on each individual duplicate occurrence for more precise diagnostics.
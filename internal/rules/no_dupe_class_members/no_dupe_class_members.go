@@ -11,6 +11,10 @@ import (
 var NoDupeClassMembersRule = rule.Rule{
 	Name:   "no-dupe-class-members",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"unexpected"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		checkClass := func(node *ast.Node) {
 			type memberState struct {
@@ -12,6 +12,10 @@ import (
 var NoDupeElseIfRule = rule.Rule{
 	Name:   "no-dupe-else-if",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"unexpected"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		sf := ctx.SourceFile
 		return rule.RuleListeners{
@@ -93,6 +93,10 @@ func (state *objectState) register(name string, kind propertyKind) bool {
 var NoDupeKeysRule = rule.Rule{
 	Name:   "no-dupe-keys",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"unexpected"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindObjectLiteralExpression: func(node *ast.Node) {
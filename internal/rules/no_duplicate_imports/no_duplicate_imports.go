@@ -230,6 +230,9 @@ func reportMessage(ctx *rule.RuleContext, node *ast.Node, id, module string) {
 var NoDuplicateImportsRule = rule.Rule{
 	Name:   "no-duplicate-imports",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type: rule.RuleTypeProblem,
+	},
 	Run: func(ctx rule.RuleContext, rawOpts []any) rule.RuleListeners {
 		opts := parseOptions(rawOpts)
 		modules := map[string][]*entry{}
@@ -37,6 +37,11 @@ var unexpectedMessage = rule.RuleMessage{
 var NoElseReturnRule = rule.Rule{
 	Name:   "no-else-return",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"unexpected"},
+	},
 	Run: func(ctx rule.RuleContext, ruleOptions []any) rule.RuleListeners {
 		opts := parseOptions(ruleOptions)
 		check := checkIfWithoutElse
@@ -18,7 +18,12 @@ var schemaJSON []byte
 var NoEmptyFunctionRule = rule.Rule{
 	Name:   "no-empty-function",
 	Schema: rule.NewSchema(schemaJSON),
-	Run:    run,
+	Meta: rule.RuleMeta{
+		Type:           rule.RuleTypeSuggestion,
+		HasSuggestions: true,
+		MessageIds:     []string{"unexpected", "suggestComment"},
+	},
+	Run: run,
 }
 
 func run(ctx rule.RuleContext, options []any) rule.RuleListeners {
@@ -14,6 +14,10 @@ var schemaJSON []byte
 var NoEmptyPatternRule = rule.Rule{
 	Name:   "no-empty-pattern",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"unexpected"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 
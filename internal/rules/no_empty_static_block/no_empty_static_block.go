@@ -10,6 +10,11 @@ import (
 var NoEmptyStaticBlockRule = rule.Rule{
 	Name:   "no-empty-static-block",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:           rule.RuleTypeSuggestion,
+		HasSuggestions: true,
+		MessageIds:     []string{"unexpected", "suggestComment"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindClassStaticBlockDeclaration: func(node *ast.Node) {
@@ -50,6 +50,10 @@ func parseOptions(options []any) bool {
 var NoEvalRule = rule.Rule{
 	Name:   "no-eval",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"unexpected"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		if !sourceMayUseEval(ctx.SourceFile) {
 			return nil
@@ -21,6 +21,10 @@ func buildExAssignMessage() rule.RuleMessage {
 var NoExAssignRule = rule.Rule{
 	Name:   "no-ex-assign",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"unexpected"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindCatchClause: func(node *ast.Node) {
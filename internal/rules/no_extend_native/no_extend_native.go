@@ -94,6 +94,10 @@ func skipParensUp(node *ast.Node) *ast.Node {
 var NoExtendNativeRule = rule.Rule{
 	Name:   "no-extend-native",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"unexpected"},
+	},
 	Run: func(ctx rule.RuleContext, rawOptions []any) rule.RuleListeners {
 		o := parseOptions(rawOptions)
 
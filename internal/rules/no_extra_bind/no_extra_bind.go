@@ -60,6 +60,11 @@ type bindMatch struct {
 var NoExtraBindRule = rule.Rule{
 	Name:   "no-extra-bind",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"unexpected"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		type scopeInfo struct {
 			match     *bindMatch
@@ -35,6 +35,11 @@ func hasBreakableBody(stmt *ast.Node) bool {
 var NoExtraLabelRule = rule.Rule{
 	Name:   "no-extra-label",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"unexpected"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		var scope *scopeInfo
 
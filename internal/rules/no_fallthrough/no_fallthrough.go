@@ -60,6 +60,10 @@ func parseOptions(options []any) noFallthroughOptions {
 var NoFallthroughRule = rule.Rule{
 	Name:   "no-fallthrough",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"unusedFallthroughComment"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 
@@ -36,6 +36,10 @@ func checkReassignments(declNode *ast.Node, name string, ctx *rule.RuleContext)
 var NoFuncAssignRule = rule.Rule{
 	Name:   "no-func-assign",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"isAFunction"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindFunctionDeclaration: func(node *ast.Node) {
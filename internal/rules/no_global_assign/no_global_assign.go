@@ -74,6 +74,10 @@ func isReadonlyGlobal(ctx rule.RuleContext, name string) bool {
 var NoGlobalAssignRule = rule.Rule{
 	Name:   "no-global-assign",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"globalShouldNotBeModified"},
+	},
 	Run: func(ctx rule.RuleContext, rawOptions []any) rule.RuleListeners {
 		opts := parseOptions(rawOptions)
 		// A single-entry cache covers the common case of repeated writes to one
@@ -54,6 +54,12 @@ func parseOptions(rawOptions []any) options {
 var NoImplicitCoercionRule = rule.Rule{
 	Name:   "no-implicit-coercion",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:           rule.RuleTypeSuggestion,
+		Fixable:        rule.FixableCode,
+		HasSuggestions: true,
+		MessageIds:     []string{"implicitCoercion", "useRecommendation"},
+	},
 	Run: func(ctx rule.RuleContext, rawOptions []any) rule.RuleListeners {
 		opts := parseOptions(rawOptions)
 
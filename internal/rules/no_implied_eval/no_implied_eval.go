@@ -42,6 +42,10 @@ func buildExecScriptMessage() rule.RuleMessage {
 var NoImpliedEvalRule = rule.Rule{
 	Name:   "no-implied-eval",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"impliedEval", "execScript"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		sctx := newStrCtx(ctx)
 
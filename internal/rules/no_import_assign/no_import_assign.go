@@ -1297,6 +1297,10 @@ func noImportAssignListeners(
 var NoImportAssignRule = rule.Rule{
 	Name:   "no-import-assign",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"readonly", "readonlyMember"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return noImportAssignListeners(ctx, noImportAssignRefStoreAuto)
 	},
@@ -45,6 +45,10 @@ func parseOptions(raw []any) options {
 var NoInlineCommentsRule = rule.Rule{
 	Name:   "no-inline-comments",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"unexpectedInlineComment"},
+	},
 	Run: func(ctx rule.RuleContext, rawOptions []any) rule.RuleListeners {
 		opts := parseOptions(rawOptions)
 		sourceFile := ctx.SourceFile
@@ -48,6 +48,10 @@ var moveDeclarationMessages = [2][3]rule.RuleMessage{
 var NoInnerDeclarationsRule = rule.Rule{
 	Name:   "no-inner-declarations",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"moveDeclToRoot"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 		allowBlockScopedFunctions := opts.blockScopedFunctions == "allow" &&
@@ -30,6 +30,10 @@ var validFlags = map[byte]bool{
 var NoInvalidRegexpRule = rule.Rule{
 	Name:   "no-invalid-regexp",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"regexMessage"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 
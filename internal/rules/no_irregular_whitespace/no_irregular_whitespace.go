@@ -18,6 +18,10 @@ var schemaJSON []byte
 var NoIrregularWhitespaceRule = rule.Rule{
 	Name:   "no-irregular-whitespace",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"noIrregularWhitespace"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 		checkNoIrregularWhitespace(ctx, opts)
@@ -10,6 +10,10 @@ import (
 var NoIteratorRule = rule.Rule{
 	Name:   "no-iterator",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"noIterator"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		report := func(node *ast.Node) {
 			ctx.ReportNode(node, rule.RuleMessage{
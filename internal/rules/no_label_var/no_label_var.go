@@ -25,6 +25,10 @@ import (
 var NoLabelVarRule = rule.Rule{
 	Name:   "no-label-var",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"identifierClashWithLabel"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		report := func(node *ast.Node) {
 			ctx.ReportNode(node, rule.RuleMessage{
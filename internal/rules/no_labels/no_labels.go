@@ -55,6 +55,10 @@ func parseOptions(options []any) ruleOptions {
 var NoLabelsRule = rule.Rule{
 	Name:   "no-labels",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"unexpectedLabel", "unexpectedLabelInBreak", "unexpectedLabelInContinue"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 
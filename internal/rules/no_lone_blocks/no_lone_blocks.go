@@ -10,6 +10,9 @@ import (
 var NoLoneBlocksRule = rule.Rule{
 	Name:   "no-lone-blocks",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type: rule.RuleTypeSuggestion,
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		var loneBlocks []*ast.Node
 
@@ -17,6 +17,11 @@ import (
 var NoLonelyIfRule = rule.Rule{
 	Name:   "no-lonely-if",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"unexpectedLonelyIf"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		sf := ctx.SourceFile
 
@@ -454,6 +454,10 @@ func (s *RunState) checkForLoopsCore(node *ast.Node) {
 var NoLoopFuncRule = rule.Rule{
 	Name:   "no-loop-func",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"unsafeRefs"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		s := &RunState{
 			Ctx:          ctx,
@@ -39,6 +39,10 @@ var decimalPowerCache [maxCachedDecimalExponent - minCachedDecimalExponent + 1]d
 var NoLossOfPrecisionRule = rule.Rule{
 	Name:   "no-loss-of-precision",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"noLossOfPrecision"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindNumericLiteral: func(node *ast.Node) {
@@ -178,7 +178,11 @@ func noMagicNumberMessage(raw string) rule.RuleMessage {
 var NoMagicNumbersRule = rule.Rule{
 	Name:   "no-magic-numbers",
 	Schema: rule.NewSchema(schemaJSON),
-	Run:    Run,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"noMagic", "useConst"},
+	},
+	Run: Run,
 }
 
 // Run implements the core ESLint no-magic-numbers rule.
@@ -34,6 +34,11 @@ var schemaJSON []byte
 var NoMisleadingCharacterClassRule = rule.Rule{
 	Name:   "no-misleading-character-class",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:           rule.RuleTypeProblem,
+		HasSuggestions: true,
+		MessageIds:     []string{"suggestUnicodeFlag"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 		var callTracker *regexpCallTracker
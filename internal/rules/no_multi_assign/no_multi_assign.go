@@ -14,6 +14,10 @@ var schemaJSON []byte
 var NoMultiAssignRule = rule.Rule{
 	Name:   "no-multi-assign",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"unexpectedChain"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 
@@ -12,6 +12,10 @@ import (
 var NoMultiStrRule = rule.Rule{
 	Name:   "no-multi-str",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"multilineString"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindStringLiteral: func(node *ast.Node) {
@@ -43,6 +43,10 @@ func hasElseWithoutCondition(ifStmt *ast.IfStatement) bool {
 var NoNegatedConditionRule = rule.Rule{
 	Name:   "no-negated-condition",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"unexpectedNegated"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindIfStatement: func(node *ast.Node) {
@@ -9,6 +9,10 @@ import (
 var NoNestedTernaryRule = rule.Rule{
 	Name:   "no-nested-ternary",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"noNestedTernary"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindConditionalExpression: func(node *ast.Node) {
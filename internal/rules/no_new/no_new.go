@@ -14,6 +14,10 @@ import (
 var NoNewRule = rule.Rule{
 	Name:   "no-new",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"noNewStatement"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindExpressionStatement: func(node *ast.Node) {
@@ -26,6 +26,10 @@ var msg = rule.RuleMessage{
 var NoNewFuncRule = rule.Rule{
 	Name:   "no-new-func",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"noFunctionConstructor"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		// isGlobalFunction checks whether an identifier resolves to the
 		// built-in Function (from lib.d.ts), not a user-declared one.
@@ -17,6 +17,10 @@ var nativeNonconstructorNames = map[string]struct{}{
 var NoNewNativeNonconstructorRule = rule.Rule{
 	Name:   "no-new-native-nonconstructor",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"noNewNonconstructor"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindNewExpression: func(node *ast.Node) {
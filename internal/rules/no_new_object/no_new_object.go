@@ -9,6 +9,12 @@ import (
 var NoNewObjectRule = rule.Rule{
 	Name:   "no-new-object",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		Deprecated: true,
+		ReplacedBy: []string{"no-object-constructor"},
+		MessageIds: []string{"preferLiteral"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindNewExpression: func(node *ast.Node) {
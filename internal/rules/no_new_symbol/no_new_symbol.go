@@ -10,6 +10,12 @@ import (
 var NoNewSymbolRule = rule.Rule{
 	Name:   "no-new-symbol",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		Deprecated: true,
+		ReplacedBy: []string{"no-new-native-nonconstructor"},
+		MessageIds: []string{"noNewSymbol"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindNewExpression: func(node *ast.Node) {
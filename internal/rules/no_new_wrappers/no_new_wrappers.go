@@ -18,6 +18,10 @@ var wrapperObjects = map[string]bool{
 var NoNewWrappersRule = rule.Rule{
 	Name:   "no-new-wrappers",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"noConstructor"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindNewExpression: func(node *ast.Node) {
@@ -11,6 +11,11 @@ import (
 var NoNonoctalDecimalEscapeRule = rule.Rule{
 	Name:   "no-nonoctal-decimal-escape",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:           rule.RuleTypeSuggestion,
+		HasSuggestions: true,
+		MessageIds:     []string{"decimalEscape", "refactor", "escapeBackslash"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		sourceText := ctx.SourceFile.Text()
 		return rule.RuleListeners{
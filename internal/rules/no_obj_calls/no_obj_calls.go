@@ -674,6 +674,10 @@ var directCallMessages = func() map[string]rule.RuleMessage {
 var NoObjCallsRule = rule.Rule{
 	Name:   "no-obj-calls",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"unexpectedRefCall", "unexpectedCall"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		if !sourceMayUseNonCallableGlobal(ctx.SourceFile) {
 			return rule.RuleListeners{}
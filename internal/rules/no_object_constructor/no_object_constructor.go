@@ -92,6 +92,11 @@ func buildSuggestion(ctx rule.RuleContext, node *ast.Node) rule.RuleSuggestion {
 var NoObjectConstructorRule = rule.Rule{
 	Name:   "no-object-constructor",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:           rule.RuleTypeSuggestion,
+		HasSuggestions: true,
+		MessageIds:     []string{"preferLiteral", "useLiteral", "useLiteralAfterSemicolon"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		check := func(node *ast.Node) {
 			callee, args := calleeAndArguments(node)
@@ -17,6 +17,10 @@ var noOctalMessage = rule.RuleMessage{
 var NoOctalRule = rule.Rule{
 	Name:   "no-octal",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"noOctal"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		sourceText := ctx.SourceFile.Text()
 		return rule.RuleListeners{
@@ -12,6 +12,10 @@ import (
 var NoOctalEscapeRule = rule.Rule{
 	Name:   "no-octal-escape",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"octalEscapeSequence"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindStringLiteral: func(node *ast.Node) {
@@ -310,6 +310,10 @@ func checkFunction(fn *ast.Node, opts Options, ctx rule.RuleContext) {
 var NoParamReassignRule = rule.Rule{
 	Name:   "no-param-reassign",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"assignmentToFunctionParam", "assignmentToFunctionParamProp"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 		handler := func(node *ast.Node) {
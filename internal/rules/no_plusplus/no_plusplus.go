@@ -71,6 +71,10 @@ func operatorText(kind ast.Kind) (string, bool) {
 var NoPlusplusRule = rule.Rule{
 	Name:   "no-plusplus",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"unexpectedUnaryOp"},
+	},
 	Run: func(ctx rule.RuleContext, rawOptions []any) rule.RuleListeners {
 		opts := parseOptions(rawOptions)
 
@@ -244,6 +244,11 @@ func (state *noPromiseExecutorReturnState) checkReturnStatement(node *ast.Node)
 var NoPromiseExecutorReturnRule = rule.Rule{
 	Name:   "no-promise-executor-return",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:           rule.RuleTypeProblem,
+		HasSuggestions: true,
+		MessageIds:     []string{"returnsValue", "prependVoid", "wrapBraces"},
+	},
 	Run: func(ctx rule.RuleContext, rawOptions []any) rule.RuleListeners {
 		if !sourceMayUsePromise(ctx.SourceFile) {
 			return nil
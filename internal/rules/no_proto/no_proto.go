@@ -10,6 +10,10 @@ import (
 var NoProtoRule = rule.Rule{
 	Name:   "no-proto",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"unexpectedProto"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		msg := rule.RuleMessage{
 			Id:          "unexpectedProto",
@@ -67,6 +67,11 @@ func isCommaBinaryExpression(node *ast.Node) bool {
 var NoPrototypeBuiltinsRule = rule.Rule{
 	Name:   "no-prototype-builtins",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:           rule.RuleTypeProblem,
+		HasSuggestions: true,
+		MessageIds:     []string{"prototypeBuildIn", "callObjectPrototype"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindCallExpression: func(node *ast.Node) {
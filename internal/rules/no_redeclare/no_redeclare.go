@@ -67,6 +67,9 @@ func typescriptDefaults() options {
 var NoRedeclareRule = rule.Rule{
 	Name:   "no-redeclare",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type: rule.RuleTypeSuggestion,
+	},
 	Run: runWithVariant(ruleVariant{
 		defaults:                 coreDefaults(),
 		includeBodylessFunctions: true,
@@ -21,6 +21,11 @@ var (
 var NoRegexSpacesRule = rule.Rule{
 	Name:   "no-regex-spaces",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"multipleSpaces"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		sf := ctx.SourceFile
 
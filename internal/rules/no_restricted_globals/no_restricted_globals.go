@@ -119,6 +119,10 @@ func parseOptions(optionsList []any, sourceIdentifiers map[string]string) (optio
 var NoRestrictedGlobalsRule = rule.Rule{
 	Name:   "no-restricted-globals",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"customMessage", "defaultMessage"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		var sourceIdentifiers map[string]string
 		if ctx.SourceFile != nil {
@@ -433,6 +433,9 @@ func parseOptions(arr []any) (groupedPaths map[string][]restrictedPathEntry, pat
 var NoRestrictedImportsRule = rule.Rule{
 	Name:   "no-restricted-imports",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type: rule.RuleTypeSuggestion,
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		groupedPaths, patternGroups := parseOptions(options)
 
@@ -26,6 +26,10 @@ var schemaJSON []byte
 var NoRestrictedSyntaxRule = rule.Rule{
 	Name:   "no-restricted-syntax",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"restrictedSyntax"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		plan := cachedRulePlan(options)
 		if len(plan.buckets) == 0 {
@@ -40,6 +40,10 @@ func parseMode(options []any) string {
 var NoReturnAssignRule = rule.Rule{
 	Name:   "no-return-assign",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"returnAssignment", "arrowAssignment"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		always := parseMode(options) == "always"
 
@@ -12,6 +12,10 @@ import (
 var NoScriptUrlRule = rule.Rule{
 	Name:   "no-script-url",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"unexpectedScriptURL"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		const jsScheme = "javascript:"
 
@@ -21,6 +21,10 @@ const skipOuterExprKinds = ast.OEKParentheses | ast.OEKTypeAssertions
 var NoSelfAssignRule = rule.Rule{
 	Name:   "no-self-assign",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"selfAssignment"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 
@@ -92,6 +92,10 @@ func firstCommaToken(node *ast.Node) *ast.Node {
 var NoSequencesRule = rule.Rule{
 	Name:   "no-sequences",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"unexpectedCommaExpression"},
+	},
 	Run: func(ctx rule.RuleContext, rawOptions []any) rule.RuleListeners {
 		opts := parseOptions(rawOptions)
 
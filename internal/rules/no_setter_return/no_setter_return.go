@@ -168,6 +168,10 @@ func isSetterFunction(node *ast.Node, ctx rule.RuleContext) bool {
 var NoSetterReturnRule = rule.Rule{
 	Name:   "no-setter-return",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"returnsValue"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindReturnStatement: func(node *ast.Node) {
@@ -129,6 +129,10 @@ func parseOptionsWith(rawOptions []any, opts options) options {
 var NoShadowRule = rule.Rule{
 	Name:   "no-shadow",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"noShadowGlobal", "noEnumShadow", "noShadow"},
+	},
 	Run: runWithVariant(ruleVariant{
 		defaults: defaultOptions(),
 	}),
@@ -167,6 +167,10 @@ func hasSameScopeNonVarUndefinedDeclaration(identNode *ast.Node, scopesWithFnOrC
 var NoShadowRestrictedNamesRule = rule.Rule{
 	Name:   "no-shadow-restricted-names",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"shadowingRestrictedName"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 		restricted := map[string]bool{
@@ -10,6 +10,10 @@ import (
 var NoSparseArraysRule = rule.Rule{
 	Name:   "no-sparse-arrays",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"unexpectedSparseArray"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindArrayLiteralExpression: func(node *ast.Node) {
@@ -11,6 +11,10 @@ import (
 var NoTemplateCurlyInStringRule = rule.Rule{
 	Name:   "no-template-curly-in-string",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"unexpectedTemplateExpression"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		regex := regexp.MustCompile(`\$\{[^}]+\}`)
 		return rule.RuleListeners{
@@ -9,6 +9,10 @@ import (
 var NoTernaryRule = rule.Rule{
 	Name:   "no-ternary",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"noTernaryOperator"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindConditionalExpression: func(node *ast.Node) {
@@ -103,6 +103,10 @@ func bodyAlwaysExits(node *ast.Node) bool {
 var NoThisBeforeSuperRule = rule.Rule{
 	Name:   "no-this-before-super",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"noBeforeSuper"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindConstructor: func(node *ast.Node) {
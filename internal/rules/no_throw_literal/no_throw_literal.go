@@ -10,6 +10,10 @@ import (
 var NoThrowLiteralRule = rule.Rule{
 	Name:   "no-throw-literal",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"object", "undef"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindThrowStatement: func(node *ast.Node) {
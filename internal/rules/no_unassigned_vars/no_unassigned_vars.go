@@ -200,6 +200,10 @@ func (s *runState) shouldSkipDeclarator(node *ast.Node) bool {
 var NoUnassignedVarsRule = rule.Rule{
 	Name:   "no-unassigned-vars",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"unassigned"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		s := &runState{ctx: ctx}
 
@@ -40,6 +40,10 @@ func parseOptions(rawOptions []any) options {
 var NoUndefRule = rule.Rule{
 	Name:   "no-undef",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"undef"},
+	},
 	Run: func(ctx rule.RuleContext, rawOptions []any) rule.RuleListeners {
 		opts := parseOptions(rawOptions)
 
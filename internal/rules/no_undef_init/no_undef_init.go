@@ -14,6 +14,11 @@ import (
 var NoUndefInitRule = rule.Rule{
 	Name:   "no-undef-init",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"unnecessaryUndefinedInit"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindVariableDeclaration: func(node *ast.Node) {
@@ -23,6 +23,10 @@ import (
 var NoUndefinedRule = rule.Rule{
 	Name:   "no-undefined",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"unexpectedUndefined"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindIdentifier: func(node *ast.Node) {
@@ -76,6 +76,10 @@ func parseOptions(options []any) noUnderscoreDangleOptions {
 var NoUnderscoreDangleRule = rule.Rule{
 	Name:   "no-underscore-dangle",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"unexpectedUnderscore"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 
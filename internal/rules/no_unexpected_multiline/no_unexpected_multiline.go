@@ -30,6 +30,10 @@ var messageDivision = rule.RuleMessage{
 var NoUnexpectedMultilineRule = rule.Rule{
 	Name:   "no-unexpected-multiline",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"function", "property", "taggedTemplate", "division"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		sf := ctx.SourceFile
 		text := sf.Text()
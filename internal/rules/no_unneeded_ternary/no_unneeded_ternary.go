@@ -196,6 +196,11 @@ func buildDefaultAssignmentFix(sf *ast.SourceFile, cond *ast.ConditionalExpressi
 var NoUnneededTernaryRule = rule.Rule{
 	Name:   "no-unneeded-ternary",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"unnecessaryConditionalExpression", "unnecessaryConditionalAssignment"},
+	},
 	Run: func(ctx rule.RuleContext, ruleOptions []any) rule.RuleListeners {
 		opts := parseOptions(ruleOptions)
 
@@ -251,6 +251,10 @@ func (s *reachabilityState) isUnreachable(node *ast.Node) bool {
 var NoUnreachableRule = rule.Rule{
 	Name:   "no-unreachable",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"unreachableCode"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		msg := rule.RuleMessage{
 			Id:          "unreachableCode",
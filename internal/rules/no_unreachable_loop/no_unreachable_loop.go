@@ -22,6 +22,10 @@ var invalidLoop = rule.RuleMessage{
 var NoUnreachableLoopRule = rule.Rule{
 	Name:   "no-unreachable-loop",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"invalid"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 		if opts.ignored == allLoopKinds {
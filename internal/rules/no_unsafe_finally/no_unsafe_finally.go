@@ -67,6 +67,10 @@ func isInFinally(node *ast.Node, isSentinel func(*ast.Node) bool, label *ast.Nod
 var NoUnsafeFinallyRule = rule.Rule{
 	Name:   "no-unsafe-finally",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"unsafeUsage"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindReturnStatement: func(node *ast.Node) {
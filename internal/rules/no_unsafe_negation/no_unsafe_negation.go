@@ -17,6 +17,11 @@ var schemaJSON []byte
 var NoUnsafeNegationRule = rule.Rule{
 	Name:   "no-unsafe-negation",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:           rule.RuleTypeProblem,
+		HasSuggestions: true,
+		MessageIds:     []string{"suggestNegatedExpression", "suggestParenthesisedNegation", "unexpected"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 
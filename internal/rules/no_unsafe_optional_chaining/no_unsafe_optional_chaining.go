@@ -15,6 +15,10 @@ var schemaJSON []byte
 var NoUnsafeOptionalChainingRule = rule.Rule{
 	Name:   "no-unsafe-optional-chaining",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"unsafeOptionalChain", "unsafeArithmetic"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		// Every optional chain contains the contiguous `?.` token. A miss is
 		// conclusive, while matches in comments or strings are harmless false
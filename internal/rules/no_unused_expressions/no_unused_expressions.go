@@ -22,6 +22,10 @@ func messageUnusedExpression() rule.RuleMessage {
 var NoUnusedExpressionsRule = rule.Rule{
 	Name:   "no-unused-expressions",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"unusedExpression"},
+	},
 	Run: func(ctx rule.RuleContext, rawOptions []any) rule.RuleListeners {
 		opts := utils.ParseNoUnusedExpressionOptions(rawOptions)
 
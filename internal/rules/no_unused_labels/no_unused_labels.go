@@ -92,6 +92,11 @@ func isFixable(ctx rule.RuleContext, node *ast.Node) bool {
 var NoUnusedLabelsRule = rule.Rule{
 	Name:   "no-unused-labels",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"unused"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		var scopeInfo *labelScope
 
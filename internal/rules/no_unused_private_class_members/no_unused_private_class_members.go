@@ -36,6 +36,11 @@ type sourceItem struct {
 var NoUnusedPrivateClassMembersRule = rule.Rule{
 	Name:   "no-unused-private-class-members",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:           rule.RuleTypeProblem,
+		HasSuggestions: true,
+		MessageIds:     []string{"unusedPrivateClassMember", "removeUnusedPrivateClassMember"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		var stack []*classMembers
 		var sourceItems []sourceItem
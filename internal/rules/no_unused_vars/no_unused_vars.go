@@ -2171,6 +2171,11 @@ func newRule() rule.Rule {
 	return rule.Rule{
 		Name:   "no-unused-vars",
 		Schema: rule.NewSchema(schemaJSON),
+		Meta: rule.RuleMeta{
+			Type:           rule.RuleTypeProblem,
+			HasSuggestions: true,
+			MessageIds:     []string{"unusedVar", "usedIgnoredVar", "removeVar"},
+		},
 		Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 			if ctx.SourceFile == nil {
 				return rule.RuleListeners{}
@@ -72,6 +72,10 @@ func parseOptions(rawOptions []any) options {
 var NoUseBeforeDefineRule = rule.Rule{
 	Name:   "no-use-before-define",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"usedBeforeDefined"},
+	},
 	Run: func(ctx rule.RuleContext, rawOptions []any) rule.RuleListeners {
 		opts := parseOptions(rawOptions)
 		if ctx.SourceFile == nil {
@@ -21,6 +21,10 @@ func buildMessage(name string) rule.RuleMessage {
 var NoUselessAssignmentRule = rule.Rule{
 	Name:   "no-useless-assignment",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"unnecessaryAssignment"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		if ctx.Refs == nil {
 			return rule.RuleListeners{}
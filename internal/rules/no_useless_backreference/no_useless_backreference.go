@@ -15,6 +15,9 @@ import (
 var NoUselessBackreferenceRule = rule.Rule{
 	Name:   "no-useless-backreference",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type: rule.RuleTypeProblem,
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		// Every pattern this rule can report contains a backslash. Constructor
 		// patterns folded by StaticStringEvaluator also get that character from
@@ -55,6 +55,10 @@ func isCallOrNonVariadicApply(node *ast.Node) (*ast.Node, string) {
 var NoUselessCallRule = rule.Rule{
 	Name:   "no-useless-call",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"unnecessaryCall"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindCallExpression: func(node *ast.Node) {
@@ -9,6 +9,10 @@ import (
 var NoUselessCatchRule = rule.Rule{
 	Name:   "no-useless-catch",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"unnecessaryCatchClause", "unnecessaryCatch"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindCatchClause: func(node *ast.Node) {
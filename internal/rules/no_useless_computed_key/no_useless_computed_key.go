@@ -130,6 +130,11 @@ func hasCommentsBetween(text string, start, end int) bool {
 var NoUselessComputedKeyRule = rule.Rule{
 	Name:   "no-useless-computed-key",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"unnecessarilyComputedProperty"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 
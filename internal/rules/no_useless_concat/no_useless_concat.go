@@ -11,6 +11,10 @@ import (
 var NoUselessConcatRule = rule.Rule{
 	Name:   "no-useless-concat",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"unexpectedConcat"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		sourceFile := ctx.SourceFile
 		lineMap := sourceFile.ECMALineMap()
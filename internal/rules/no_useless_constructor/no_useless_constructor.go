@@ -217,6 +217,11 @@ func needsLeadingSemicolon(sf *ast.SourceFile, classNode *ast.Node, node *ast.No
 var NoUselessConstructorRule = rule.Rule{
 	Name:   "no-useless-constructor",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:           rule.RuleTypeSuggestion,
+		HasSuggestions: true,
+		MessageIds:     []string{"noUselessConstructor", "removeConstructor"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindConstructor: func(node *ast.Node) {
@@ -25,6 +25,11 @@ var schemaJSON []byte
 var NoUselessEscapeRule = rule.Rule{
 	Name:   "no-useless-escape",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:           rule.RuleTypeSuggestion,
+		HasSuggestions: true,
+		MessageIds:     []string{"removeEscape", "removeEscapeDoNotKeepSemantics", "escapeBackslash", "unnecessaryEscape"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		allowed := parseAllowRegexCharacters(options)
 
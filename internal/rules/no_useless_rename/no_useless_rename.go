@@ -250,6 +250,11 @@ func (s *noUselessRenameState) walkAssignmentPattern(node *ast.Node) {
 var NoUselessRenameRule = rule.Rule{
 	Name:   "no-useless-rename",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"unnecessarilyRenamed"},
+	},
 	Run: func(ctx rule.RuleContext, optionsAny []any) rule.RuleListeners {
 		opts := parseOptions(optionsAny)
 		if opts.ignoreDestructuring && opts.ignoreImport && opts.ignoreExport {
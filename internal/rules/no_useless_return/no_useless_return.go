@@ -20,6 +20,11 @@ var unnecessaryReturn = rule.RuleMessage{
 var NoUselessReturnRule = rule.Rule{
 	Name:   "no-useless-return",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"unnecessaryReturn"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		// Only the code paths that hold a bare return need a graph.
 		var rootOrder []*ast.Node
@@ -14,6 +14,10 @@ var schemaJSON []byte
 var NoVoidRule = rule.Rule{
 	Name:   "no-void",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"noVoid"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 
@@ -23,6 +23,10 @@ const charLimit = 40
 var NoWarningCommentsRule = rule.Rule{
 	Name:   "no-warning-comments",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"unexpectedComment"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 		escapedDecoration := escapeRegExp(strings.Join(opts.Decoration, ""))
@@ -9,6 +9,10 @@ import (
 var NoWithRule = rule.Rule{
 	Name:   "no-with",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"unexpectedWith"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindWithStatement: func(node *ast.Node) {
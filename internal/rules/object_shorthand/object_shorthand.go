@@ -358,6 +358,11 @@ func declarationListDeclaresBlockScopedArguments(node *ast.Node) bool {
 var ObjectShorthandRule = rule.Rule{
 	Name:   "object-shorthand",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"expectedPropertyShorthand", "expectedMethodShorthand", "expectedPropertyLongform", "expectedMethodLongform", "expectedLiteralMethodLongform", "expectedAllPropertiesShorthanded", "unexpectedMix"},
+	},
 	Run: func(ctx rule.RuleContext, ruleOptions []any) rule.RuleListeners {
 		opts := parseOptions(ruleOptions)
 		sourceText := ctx.SourceFile.Text()
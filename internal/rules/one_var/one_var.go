@@ -670,6 +670,10 @@ func currentScope(key string, funcStack []funcScope, blockStack []blockScope) *f
 var OneVarRule = rule.Rule{
 	Name:   "one-var",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:    rule.RuleTypeSuggestion,
+		Fixable: rule.FixableCode,
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 		needsVarScope := hasMode(opts.var_, modeAlways)
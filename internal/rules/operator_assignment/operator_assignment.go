@@ -444,6 +444,11 @@ func checkNever(ctx *rule.RuleContext, node *ast.Node) {
 var OperatorAssignmentRule = rule.Rule{
 	Name:   "operator-assignment",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"replaced", "unexpected"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		never := false
 		if len(options) > 0 {
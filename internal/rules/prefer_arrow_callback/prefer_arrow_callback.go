@@ -504,6 +504,11 @@ func buildFixes(ctx rule.RuleContext, node *ast.Node, scope scopeInfo, info call
 var PreferArrowCallbackRule = rule.Rule{
 	Name:   "prefer-arrow-callback",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"preferArrowCallback"},
+	},
 	Run: func(ctx rule.RuleContext, raw []any) rule.RuleListeners {
 		opts := parseOptions(raw)
 		return rule.RuleListeners{
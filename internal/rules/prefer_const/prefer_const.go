@@ -92,6 +92,11 @@ type preferConstState struct {
 var PreferConstRule = rule.Rule{
 	Name:   "prefer-const",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"useConst"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		sourceText := ctx.SourceFile.Text()
 		// A real let declaration necessarily contains this exact byte sequence.
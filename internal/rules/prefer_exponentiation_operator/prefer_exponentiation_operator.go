@@ -449,6 +449,11 @@ func buildFixes(sf *ast.SourceFile, node *ast.Node) []rule.RuleFix {
 var PreferExponentiationOperatorRule = rule.Rule{
 	Name:   "prefer-exponentiation-operator",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"useExponentiation"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		sourceFile := ctx.SourceFile
 		message := buildUseExponentiationMessage()
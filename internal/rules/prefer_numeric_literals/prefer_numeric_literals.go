@@ -35,6 +35,11 @@ func useLiteralMessage(system string, functionName string) rule.RuleMessage {
 var PreferNumericLiteralsRule = rule.Rule{
 	Name:   "prefer-numeric-literals",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"useLiteral"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindCallExpression: func(node *ast.Node) {
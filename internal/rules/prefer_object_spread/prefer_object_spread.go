@@ -733,6 +733,11 @@ func buildFixes(ctx rule.RuleContext, node *ast.Node, args []*ast.Node) []rule.R
 var PreferObjectSpreadRule = rule.Rule{
 	Name:   "prefer-object-spread",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"useSpreadMessage", "useLiteralMessage"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		tracker := &objectTracker{
 			ctx:       ctx,
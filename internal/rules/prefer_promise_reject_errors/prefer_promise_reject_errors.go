@@ -69,6 +69,10 @@ func isEffectiveGlobalIdentifier(ctx rule.RuleContext, node *ast.Node, name stri
 var PreferPromiseRejectErrorsRule = rule.Rule{
 	Name:   "prefer-promise-reject-errors",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"rejectAnError"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 		return rule.RuleListeners{
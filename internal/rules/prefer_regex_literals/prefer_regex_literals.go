@@ -44,6 +44,11 @@ type ruleOptions struct {
 var PreferRegexLiteralsRule = rule.Rule{
 	Name:   "prefer-regex-literals",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:           rule.RuleTypeSuggestion,
+		HasSuggestions: true,
+		MessageIds:     []string{"unexpectedRegExp", "unexpectedRedundantRegExpWithFlags", "unexpectedRedundantRegExp"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 
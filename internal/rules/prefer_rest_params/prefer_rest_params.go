@@ -9,6 +9,10 @@ import (
 var PreferRestParamsRule = rule.Rule{
 	Name:   "prefer-rest-params",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"preferRestParams"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindIdentifier: func(node *ast.Node) {
@@ -14,6 +14,11 @@ import (
 var PreferTemplateRule = rule.Rule{
 	Name:   "prefer-template",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"unexpectedStringConcatenation"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		// Tracks concat chains already reported, keyed by the top binary
 		// expression's position, so nested literals don't double-report.
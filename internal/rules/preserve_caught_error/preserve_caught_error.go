@@ -448,6 +448,11 @@ func suggestIncludeCause(fixes []rule.RuleFix) []rule.RuleSuggestion {
 var PreserveCaughtErrorRule = rule.Rule{
 	Name:   "preserve-caught-error",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:           rule.RuleTypeSuggestion,
+		HasSuggestions: true,
+		MessageIds:     []string{"missingCause", "incorrectCause", "includeCause", "missingCatchErrorParam", "partiallyLostError", "caughtErrorShadowed"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 
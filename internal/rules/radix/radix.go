@@ -96,6 +96,11 @@ func hasTrailingComma(sourceText string, lastArgEnd, callEnd int) bool {
 var RadixRule = rule.Rule{
 	Name:   "radix",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:           rule.RuleTypeSuggestion,
+		HasSuggestions: true,
+		MessageIds:     []string{"missingParameters", "missingRadix", "invalidRadix", "addRadixParameter10"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindCallExpression: func(node *ast.Node) {
@@ -1144,6 +1144,10 @@ func parseOptions(options []any) bool {
 var RequireAtomicUpdatesRule = rule.Rule{
 	Name:   "require-atomic-updates",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"nonAtomicUpdate", "nonAtomicObjectUpdate"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		allowProperties := parseOptions(options)
 
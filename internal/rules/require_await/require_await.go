@@ -103,6 +103,11 @@ func reportMissingAwait(ctx rule.RuleContext, node *ast.Node) {
 var RequireAwaitRule = rule.Rule{
 	Name:   "require-await",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:           rule.RuleTypeSuggestion,
+		HasSuggestions: true,
+		MessageIds:     []string{"missingAwait", "removeAsync"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		stack := make([]scopeFrame, 0, 8)
 
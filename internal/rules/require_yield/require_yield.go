@@ -99,6 +99,10 @@ func generatorHeadRange(sourceFile *ast.SourceFile, node *ast.Node) core.TextRan
 var RequireYieldRule = rule.Rule{
 	Name:   "require-yield",
 	Schema: rule.EmptyArraySchema,
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		MessageIds: []string{"missingYield"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		if !strings.Contains(ctx.SourceFile.Text(), "*") {
 			return nil
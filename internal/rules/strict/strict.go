@@ -375,6 +375,11 @@ func parseOptions(options []any) strictMode {
 var StrictRule = rule.Rule{
 	Name:   "strict",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeSuggestion,
+		Fixable:    rule.FixableCode,
+		MessageIds: []string{"global", "nonSimpleParameterList", "unnecessary", "unnecessaryInClasses", "function", "wrap"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		m := parseOptions(options)
 
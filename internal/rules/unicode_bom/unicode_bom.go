@@ -62,6 +62,11 @@ func hasBOM(ctx rule.RuleContext) bool {
 var UnicodeBomRule = rule.Rule{
 	Name:   "unicode-bom",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeLayout,
+		Fixable:    rule.FixableWhitespace,
+		MessageIds: []string{"expected", "unexpected"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		requireBOM := parseOptions(options)
 		hasBOM := hasBOM(ctx)
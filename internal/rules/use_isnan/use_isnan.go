@@ -211,6 +211,10 @@ func parseOptions(options []any) useIsNaNOptions {
 var UseIsNaNRule = rule.Rule{
 	Name:   "use-isnan",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:       rule.RuleTypeProblem,
+		MessageIds: []string{"comparisonWithNaN", "switchNaN", "caseNaN", "indexOfNaN"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		if !sourceMayUseNaN(ctx.SourceFile) {
 			return nil
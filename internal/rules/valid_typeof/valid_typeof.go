@@ -73,6 +73,11 @@ func isEqualityOperator(kind ast.Kind) bool {
 var ValidTypeofRule = rule.Rule{
 	Name:   "valid-typeof",
 	Schema: rule.NewSchema(schemaJSON),
+	Meta: rule.RuleMeta{
+		Type:           rule.RuleTypeProblem,
+		HasSuggestions: true,
+		MessageIds:     []string{"invalidValue", "notString", "suggestString"},
+	},
 	Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 		opts := parseOptions(options)
 
@@ -384,6 +384,10 @@ func NewRule(config Config) rule.Rule {
 	return rule.Rule{
 		Name:   config.Name,
 		Schema: rule.NewSchema(schemaJSON),
+		Meta: rule.RuleMeta{
+			Type:       rule.RuleTypeSuggestion,
+			MessageIds: []string{"noAssertions"},
+		},
 		Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 			runtime := config.Prepare(ctx)
 			assertNames, additionalTestBlocks := parseOptions(options, config.DefaultAssertFunctionNames)
@@ -60,6 +60,10 @@ func NewRule(config Config) rule.Rule {
 	return rule.Rule{
 		Name:   config.Name,
 		Schema: rule.EmptyArraySchema,
+		Meta: rule.RuleMeta{
+			Type:       rule.RuleTypeSuggestion,
+			MessageIds: []string{"missingFunction", "skippedTest"},
+		},
 		Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 			runtime := config.Prepare(ctx)
 			if runtime.Skip {
@@ -53,6 +53,10 @@ func NewRule(config Config) rule.Rule {
 	return rule.Rule{
 		Name:   config.Name,
 		Schema: rule.EmptyArraySchema,
+		Meta: rule.RuleMeta{
+			Type:       rule.RuleTypeProblem,
+			MessageIds: []string{"multipleTestTitle", "multipleDescribeTitle"},
+		},
 		Run: func(ctx rule.RuleContext, options []any) rule.RuleListeners {
 			runtime := config.Prepare(ctx)
 			if runtime.Skip {
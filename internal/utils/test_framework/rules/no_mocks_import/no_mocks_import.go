@@ -16,6 +16,10 @@ func NewRule(name string, mockFunction string) rule.Rule {
 	return rule.Rule{
 		Name:   name,
 		Schema: rule.EmptyArraySchema,
+		Meta: rule.RuleMeta{
+			Type:       rule.RuleTypeProblem,
+			MessageIds: []string{"noManualImport"},
+		},
 		Run: func(ctx rule.RuleContext, _ []any) rule.RuleListeners {
 			return rule.RuleListeners{
 				ast.KindImportDeclaration: func(node *ast.Node) {
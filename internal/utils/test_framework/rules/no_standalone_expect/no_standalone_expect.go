@@ -200,6 +200,10 @@ func NewRule(config Config) rule.Rule {
 	return rule.Rule{
 		Name:   config.Name,
 		Schema: config.Schema,
+		Meta: rule.RuleMeta{
+			Type:       rule.RuleTypeProblem,
+			MessageIds: []string{"unexpectedExpect"},
+		},
 		Run: func(ctx rule.RuleContext, rawOptions []any) rule.RuleListeners {
 			runtime := config.Prepare(ctx)
 			if runtime.Skip {
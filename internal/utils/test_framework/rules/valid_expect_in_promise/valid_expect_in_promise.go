@@ -94,6 +94,10 @@ func NewRule(config Config) rule.Rule {
 	return rule.Rule{
 		Name:   config.Name,
 		Schema: rule.EmptyArraySchema,
+		Meta: rule.RuleMeta{
+			Type:       rule.RuleTypeProblem,
+			MessageIds: []string{"expectInFloatingPromise"},
+		},
 		Run: func(ctx rule.RuleContext, _ []any) rule.RuleListeners {
 			runtime := config.Prepare(ctx)
 			analyzer := &analyzer{
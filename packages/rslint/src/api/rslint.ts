@@ -63,6 +63,19 @@ export interface RslintOptions {
   overrideConfigFile?: string | true | null;
   /** Apply rule auto-fixes; results carry `output` (the JS side persists via outputFixes). */
   fix?: boolean;
+  /**
+   * Report `eslint-disable` / `rslint-disable` directives that suppress no
+   * problem, at this severity, with fixes that remove them (ESLint's
+   * `--report-unused-disable-directives-severity`). Overrides the config's
+   * `linterOptions.reportUnusedDisableDirectives`; absent leaves it in charge.
+   */
+  reportUnusedDisableDirectives?: 'off' | 'warn' | 'error' | 0 | 1 | 2;
+  /**
+   * Ignore `eslint-disable`, `/* global *\/` and other directive comments,
+   * whatever `linterOptions.noInlineConfig` says (ESLint's
+   * `allowInlineConfig: false`).
+   */
+  noInlineConfig?: boolean;
   /**
    * In-memory file overlay (path → content) for project inputs (issue #1106):
    * put the `tsconfig.json` that `parserOptions.project` names plus any
@@ -269,6 +282,8 @@ export class Rslint {
   readonly #overrideConfig?: RslintConfigEntry | RslintConfig | null;
   readonly #overrideConfigFile?: string | true | null;
   readonly #fix: boolean;
+  readonly #reportUnusedDisableDirectives?: string;
+  readonly #noInlineConfig: boolean;
   readonly #virtualFiles?: Record<string, string>;
   readonly #pluginHosts = new PluginHostLifecycle();
   #normalizedOverrideConfig?: Record<string, unknown>[];
@@ -280,6 +295,12 @@ export class Rslint {
     this.#overrideConfig = options.overrideConfig;
     this.#overrideConfigFile = options.overrideConfigFile;
     this.#fix = options.fix ?? false;
+    if (options.reportUnusedDisableDirectives !== undefined) {
+      this.#reportUnusedDisableDirectives = String(
+        options.reportUnusedDisableDirectives,
+      );
+    }
+    this.#noInlineConfig = options.noInlineConfig ?? false;
     this.#virtualFiles = options.virtualFiles;
     this.#service = new RSLintService(new NodeRslintService());
   }
@@ -331,6 +352,8 @@ export class Rslint {
           // same-path code entry wins so `lintText` always lints `code`.
           fileContents: { ...this.#resolveOverlay(), [filePath]: code },
           fix: this.#fix,
+          reportUnusedDisableDirectives: this.#reportUnusedDisableDirectives,
+          noInlineConfig: this.#noInlineConfig,
         },
         discoverySession?.handlers ?? {},
       );
@@ -469,6 +492,8 @@ export class Rslint {
           // Overlay (e.g. an in-memory tsconfig) for the program over disk files.
           fileContents: this.#resolveOverlay(),
           fix: this.#fix,
+          reportUnusedDisableDirectives: this.#reportUnusedDisableDirectives,
+          noInlineConfig: this.#noInlineConfig,
         },
         discoverySession?.handlers ?? {},
       );
@@ -206,7 +206,12 @@ export function normalizeConfig(config: unknown): Record<string, unknown>[] {
       );
     }
 
-    for (const key of ['rules', 'languageOptions', 'settings'] as const) {
+    for (const key of [
+      'rules',
+      'languageOptions',
+      'linterOptions',
+      'settings',
+    ] as const) {
       if (
         Object.prototype.hasOwnProperty.call(entry, key) &&
         (entry[key] === null ||
@@ -233,6 +238,10 @@ export function normalizeConfig(config: unknown): Record<string, unknown>[] {
       validateGlobals(languageOptions.globals, index);
     }
 
+    if (isRecord(entry.linterOptions)) {
+      validateLinterOptions(entry.linterOptions, index);
+    }
+
     const hasPlugins = Object.prototype.hasOwnProperty.call(entry, 'plugins');
     if (
       hasPlugins &&
@@ -308,6 +317,7 @@ export function normalizeConfig(config: unknown): Record<string, unknown>[] {
     const serializesNonGlobalKey =
       hasFiles ||
       entry.languageOptions !== undefined ||
+      entry.linterOptions !== undefined ||
       entry.rules !== undefined ||
       hasPlugins ||
       entry.settings !== undefined;
@@ -321,6 +331,9 @@ export function normalizeConfig(config: unknown): Record<string, unknown>[] {
       ...(entry.languageOptions !== undefined
         ? { languageOptions: entry.languageOptions }
         : {}),
+      ...(entry.linterOptions !== undefined
+        ? { linterOptions: entry.linterOptions }
+        : {}),
       ...(entry.rules !== undefined ? { rules: entry.rules } : {}),
       ...(hasPlugins ? { plugins } : {}),
       ...(entry.settings !== undefined
@@ -333,6 +346,41 @@ export function normalizeConfig(config: unknown): Record<string, unknown>[] {
   });
 }
 
+const SEVERITY_VALUES = new Set<unknown>(['off', 'warn', 'error', 0, 1, 2]);
+
+function validateLinterOptions(
+  linterOptions: Record<string, unknown>,
+  entryIndex: number,
+): void {
+  const {
+    noInlineConfig,
+    reportUnusedDisableDirectives,
+    reportUnusedInlineConfigs,
+  } = linterOptions;
+  if (noInlineConfig !== undefined && typeof noInlineConfig !== 'boolean') {
+    throw new Error(
+      `[rslint] Config entry at index ${entryIndex}: "linterOptions.noInlineConfig" must be a boolean`,
+    );
+  }
+  if (
+    reportUnusedDisableDirectives !== undefined &&
+    typeof reportUnusedDisableDirectives !== 'boolean' &&
+    !SEVERITY_VALUES.has(reportUnusedDisableDirectives)
+  ) {
+    throw new Error(
+      `[rslint] Config entry at index ${entryIndex}: "linterOptions.reportUnusedDisableDirectives" must be a severity or a boolean`,
+    );
+  }
+  if (
+    reportUnusedInlineConfigs !== undefined &&
+    !SEVERITY_VALUES.has(reportUnusedInlineConfigs)
+  ) {
+    throw new Error(
+      `[rslint] Config entry at index ${entryIndex}: "linterOptions.reportUnusedInlineConfigs" must be a severity`,
+    );
+  }
+}
+
 const GLOBAL_ACCESS_VALUES = new Set<unknown>([
   true,
   'true',
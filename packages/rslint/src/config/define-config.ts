@@ -162,6 +162,25 @@ export interface LanguageOptions {
   globals?: GlobalsConfig;
 }
 
+/**
+ * How inline configuration comments are treated. Later config entries
+ * override the fields they set.
+ */
+export interface LinterOptions {
+  /**
+   * Ignore `eslint-disable` / `rslint-disable` and `/* global *\/` comments.
+   * Each ignored comment is reported as a warning.
+   */
+  noInlineConfig?: boolean;
+  /**
+   * Report disable directives that suppress no problem. `true` means
+   * `'warn'` and `false` means `'off'`.
+   */
+  reportUnusedDisableDirectives?: RuleSeverity | boolean;
+  /** Report inline rule configuration comments that change nothing. */
+  reportUnusedInlineConfigs?: RuleSeverity;
+}
+
 /**
  * A real ESLint plugin object, as exported by community packages
  * (`eslint-plugin-unicorn`, etc.). Only the fields rslint consumes are
@@ -200,6 +219,8 @@ export interface RslintConfigEntry {
   ignores?: string[];
   /** Language-level configuration (parser, etc.). */
   languageOptions?: LanguageOptions;
+  /** How inline configuration comments are treated in matching files. */
+  linterOptions?: LinterOptions;
   /**
    * Plugins enabled for this entry. Two forms:
    *
@@ -139,6 +139,12 @@ export interface LintFileRequest {
   };
   /** Merged flat-config `settings` for plugin consumption (e.g. `react.version`). */
   settings?: Record<string, unknown>;
+  /**
+   * Effective `linterOptions.noInlineConfig` (or `--no-inline-config`).
+   * When set, `eslint-disable` / `eslint-enable` comments are ignored and
+   * every diagnostic is kept.
+   */
+  noInlineConfig?: boolean;
   /** Map of fully-qualified rule name → config. Already enabled-filtered. */
   rules: Record<string, RuleConfig>;
   /**
@@ -597,7 +603,7 @@ export function lintFile(
   // diagnostics → nothing to filter, and there's no SourceCode to pull
   // comments from anyway.
   const filteredDiagnostics =
-    ruleContexts.length === 0
+    ruleContexts.length === 0 || req.noInlineConfig
       ? staged
       : applyDisableDirectives({
           comments: ruleContexts[0].ctx.sourceCode.getAllComments(),
@@ -52,6 +52,8 @@ export interface EslintPluginLintRequest {
      */
     languageOptions?: unknown;
     settings?: Record<string, unknown>;
+    /** Effective `linterOptions.noInlineConfig` for the file. */
+    noInlineConfig?: boolean;
     /**
      * The owning config's absolute filesystem directory in the same form the
      * host used as its `ConfigDescriptor.configDirectory`.
@@ -124,6 +126,7 @@ export function buildPluginLintTasks(
       text: f.text,
       languageOptions: f.languageOptions as never,
       settings: f.settings,
+      noInlineConfig: f.noInlineConfig,
       rules: sharedRules,
       collectFixes,
       suggestionsMode,
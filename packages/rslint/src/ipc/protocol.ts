@@ -20,6 +20,7 @@ export type MessageKind =
   // ── shared infrastructure (also consumed by `--api` mode for wasm/rslint-api) ──
   | 'lint'
   | 'getAstInfo'
+  | 'getRuleMeta'
   | 'response'
   | 'error'
   | 'handshake'
@@ -4,6 +4,8 @@ import type {
   LintResponse,
   GetAstInfoRequest,
   GetAstInfoResponse,
+  GetRuleMetaRequest,
+  GetRuleMetaResponse,
   IpcMessage,
   LintInboundHandlers,
 } from '../types.js';
@@ -152,6 +154,23 @@ export class RSLintService {
     });
   }
 
+  /**
+   * Get the metadata of registered rules: type, documentation URL,
+   * fixability, and deprecation
+   */
+  async getRuleMeta(
+    options: GetRuleMetaRequest = {},
+  ): Promise<GetRuleMetaResponse> {
+    if (this.closeRequested) {
+      throw new Error('rslint service is closing');
+    }
+
+    return this.enqueue(async () => {
+      await this.handshake({ pluginLint: false, configLoad: false });
+      return this.service.sendMessage('getRuleMeta', { rules: options.rules });
+    });
+  }
+
   /**
    * Close the service
    */
@@ -301,6 +320,9 @@ export type {
   // AST Info types
   GetAstInfoRequest,
   GetAstInfoResponse,
+  GetRuleMetaRequest,
+  GetRuleMetaResponse,
+  RuleMeta,
   NodeInfo,
   TypeInfo,
   SymbolInfo,
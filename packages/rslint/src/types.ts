@@ -112,6 +112,12 @@ export interface LintOptions {
   // and languageOptions live in the config entries — there is no separate
   // ruleOptions / languageOptions override surface.
   fix?: boolean;
+  // Severity ('off' | 'warn' | 'error' | '0' | '1' | '2') for disable
+  // directives that suppress nothing, overriding the config's
+  // linterOptions; omitted leaves the config in charge.
+  reportUnusedDisableDirectives?: string;
+  // Ignore directive comments regardless of linterOptions.noInlineConfig.
+  noInlineConfig?: boolean;
 }
 
 export interface RSlintOptions {
@@ -176,6 +182,34 @@ export interface GetAstInfoResponse {
   flow?: FlowInfo;
 }
 
+/**
+ * Request for the metadata of registered rules
+ */
+export interface GetRuleMetaRequest {
+  rules?: string[]; // Rule names to describe (default: every registered rule)
+}
+
+/**
+ * Metadata of one registered rule, modelled on ESLint's rule meta
+ */
+export interface RuleMeta {
+  name: string;
+  type?: 'problem' | 'suggestion' | 'layout';
+  docsUrl?: string;
+  fixable?: 'code' | 'whitespace';
+  hasSuggestions?: boolean;
+  deprecated?: boolean;
+  replacedBy?: string[];
+  messageIds?: string[];
+}
+
+/**
+ * Response listing rule metadata, sorted by rule name. Unknown rules are omitted.
+ */
+export interface GetRuleMetaResponse {
+  rules: RuleMeta[];
+}
+
 /**
  * NodeList metadata (Pos, End, HasTrailingComma)
  */
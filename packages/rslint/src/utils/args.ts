@@ -6,6 +6,7 @@ export const OUTPUT_FORMATS = [
   'jsonline',
   'github',
   'gitlab',
+  'sarif',
 ] as const;
 
 export type OutputFormat = (typeof OUTPUT_FORMATS)[number];
@@ -38,6 +39,9 @@ export function parseArgs(argv: string[]) {
       rule: { type: 'string', multiple: true },
       trace: { type: 'string' },
       cpuprof: { type: 'string' },
+      'cache-location': { type: 'string' },
+      'cache-strategy': { type: 'string' },
+      'report-unused-disable-directives-severity': { type: 'string' },
       // Consumed by the JS entry point; must not reach Go from user input.
       'start-time': { type: 'string' },
     },
@@ -46,7 +46,7 @@ describe('isJSConfigFile', () => {
 
 describe('isOutputFormat', () => {
   test('accepts every CLI output protocol', () => {
-    for (const format of ['default', 'jsonline', 'github', 'gitlab']) {
+    for (const format of ['default', 'jsonline', 'github', 'gitlab', 'sarif']) {
       expect(isOutputFormat(format)).toBe(true);
     }
   });
@@ -88,6 +88,40 @@ describe('parseArgs positionals', () => {
     expect(result.positionals).toEqual(['src/a.ts']);
   });
 
+  test('--cache-location and --cache-strategy values not in positionals', () => {
+    const result = parseArgs([
+      '--cache',
+      '--cache-location',
+      '.cache/rslint',
+      '--cache-strategy',
+      'content',
+      'src/a.ts',
+    ]);
+    expect(result.positionals).toEqual(['src/a.ts']);
+    expect(result.rest).toEqual([
+      '--cache',
+      '--cache-location',
+      '.cache/rslint',
+      '--cache-strategy',
+      'content',
+      'src/a.ts',
+    ]);
+  });
+
+  test('--report-unused-disable-directives-severity value not in positionals', () => {
+    const result = parseArgs([
+      '--report-unused-disable-directives-severity',
+      'warn',
+      'src/a.ts',
+    ]);
+    expect(result.positionals).toEqual(['src/a.ts']);
+    expect(result.rest).toEqual([
+      '--report-unused-disable-directives-severity',
+      'warn',
+      'src/a.ts',
+    ]);
+  });
+
   test('multiple files with flags interspersed', () => {
     const result = parseArgs(['src/a.ts', '--format', 'jsonline', 'src/b.ts']);
     expect(result.positionals).toEqual(['src/a.ts', 'src/b.ts']);
@@ -410,9 +410,19 @@ export class Rslint implements Disposable {
     this.assertStartCurrent(epoch, signal);
     this.logger.info('Rslint binary path:', binPath);
 
+    const unusedDirectiveSeverity = workspace
+      .getConfiguration('rslint', this.workspaceFolder.uri)
+      .get<string>('reportUnusedDisableDirectives', 'config');
+    const serverArgs = ['--lsp'];
+    if (unusedDirectiveSeverity !== 'config') {
+      serverArgs.push(
+        '--report-unused-disable-directives-severity',
+        unusedDirectiveSeverity,
+      );
+    }
     const serverProcessOwner = new LanguageServerProcessOwner(
       binPath,
-      ['--lsp'],
+      serverArgs,
       this.workspaceFolder.uri.fsPath,
     );
     this.serverProcessOwner = serverProcessOwner;